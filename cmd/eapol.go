@@ -1,18 +1,249 @@
 package main
 
-import "github.com/sdir/eapol_test/session"
+import (
+	"crypto/tls"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sdir/eapol_test/radius"
+	"github.com/sdir/eapol_test/session"
+)
+
+// stringList collects the values of a repeatable flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// newPool builds a pool of network servers from "addr[,secret[,weight]]"
+// specs. Servers without a port use port and without a secret the -secret
+// one.
+func newPool(specs []string, network, port, secret string, config *tls.Config) *radius.Pool {
+	var servers []*radius.Server
+	for _, spec := range specs {
+		fields := strings.Split(spec, ",")
+		server := &radius.Server{Network: network, Addr: fields[0], Secret: secret, TLS: config}
+		if _, _, err := net.SplitHostPort(server.Addr); err != nil {
+			server.Addr = net.JoinHostPort(server.Addr, port)
+		}
+		if len(fields) > 1 && fields[1] != "" {
+			server.Secret = fields[1]
+		}
+		if len(fields) > 2 {
+			weight, err := strconv.Atoi(fields[2])
+			if err != nil {
+				log.Fatalf("invalid weight in server %q", spec)
+			}
+			server.Weight = weight
+		}
+		servers = append(servers, server)
+	}
+	return radius.NewPool(servers...)
+}
 
 func main() {
-	context := &session.Context{
-		UserName:   "username",
-		PassWord:   "password",
-		NasAddr:    "192.168.111.111",
-		NasPort:    "Ethernet0/0/4",
-		NasPasswd:  "sercet",
-		VlanID:     0,
-		ClientAddr: "10.10.10.10",
-		ClientMac:  "12:AB:AC:83:1D:12",
-	}
-	s := session.New("192.168.111.120", context)
+	context := &session.Context{}
+	server := flag.String("server", "192.168.111.120", "RADIUS server as host[:port], IPv6 link-local with a %zone")
+	method := flag.String("method", "peap", "authentication method: peap, pap or chap")
+	status := flag.Bool("status", false, "only probe the server with Status-Server")
+	strict := flag.Bool("strict", false, "enforce the BlastRADIUS Message-Authenticator rules")
+	verbose := flag.Bool("x", false, "log every request and reply with its decoded attributes")
+	pcapFile := flag.String("pcap", "", "record the packets and the PEAP key log to a pcapng file")
+	acct := flag.Bool("acct", false, "run accounting after Access-Accept until -duration or interrupt")
+	acctOn := flag.Bool("acct-on", false, "send Accounting-On before Start")
+	interim := flag.Duration("interim", 0, "Interim-Update interval (default Acct-Interim-Interval of the Access-Accept)")
+	duration := flag.Duration("duration", 0, "session duration (default Session-Timeout of the Access-Accept)")
+	dynAuth := flag.String("dynauth", "", "address answering CoA and Disconnect during accounting, e.g. :3799")
+	rate := flag.Uint64("rate", 0, "synthetic traffic in octets per second, in each direction")
+	octets := flag.Uint64("octets", 0, "initial octet counters, e.g. 4294000000 to test Gigawords wrap")
+	retransmit := radius.DefaultRetransmitPolicy
+	flag.DurationVar(&retransmit.IRT, "irt", retransmit.IRT, "initial retransmission timeout, 0 disables retransmission")
+	flag.IntVar(&retransmit.MRC, "mrc", retransmit.MRC, "maximum retransmission count, 0 for no limit")
+	flag.DurationVar(&retransmit.MRT, "mrt", retransmit.MRT, "maximum retransmission timeout, 0 for no limit")
+	flag.DurationVar(&retransmit.MRD, "mrd", retransmit.MRD, "maximum retransmission duration, 0 for no limit")
+	flag.StringVar(&context.UserName, "user", "username", "user name")
+	flag.StringVar(&context.PassWord, "password", "password", "user password")
+	flag.StringVar(&context.NasAddr, "nas-addr", "192.168.111.111", "NAS-IP-Address or NAS-IPv6-Address, comma separated")
+	flag.StringVar(&context.NasPort, "nas-port", "Ethernet0/0/4", "NAS port interface name")
+	flag.StringVar(&context.NasPasswd, "secret", "sercet", "RADIUS shared secret")
+	flag.StringVar(&context.ClientAddr, "client-addr", "10.10.10.10", "Framed-IP-Address or Framed-IPv6-Address, comma separated")
+	flag.StringVar(&context.ClientPrefix, "client-prefix", "", "Framed-IPv6-Prefix, e.g. 2001:db8:1::/64")
+	flag.StringVar(&context.ClientInterfaceID, "client-ifid", "", "Framed-Interface-Id, e.g. 0000:0000:0000:0001")
+	flag.StringVar(&context.DelegatedPrefix, "delegated-prefix", "", "Delegated-IPv6-Prefix, e.g. 2001:db8:2::/56")
+	flag.StringVar(&context.ClientMac, "client-mac", "12:AB:AC:83:1D:12", "Calling-Station-Id")
+	vlan := flag.Uint("vlan", 0, "VLAN ID")
+	strategy := flag.String("strategy", "ordered", "server pool selection: ordered, round-robin or weighted")
+	deadTime := flag.Duration("dead-time", 30*time.Second, "time a server that stopped answering is skipped")
+	statusCheck := flag.Bool("status-check", false, "revive dead servers only once they answer Status-Server")
+	transport := flag.String("transport", "udp", "transport to the servers: udp, tcp, tls (RadSec) or dtls")
+	certFile := flag.String("cert", "", "tls and dtls client certificate PEM file")
+	keyFile := flag.String("key", "", "tls and dtls client key PEM file")
+	caFile := flag.String("ca", "", "tls and dtls server CA PEM file (default system CAs)")
+	keepalive := flag.Duration("keepalive", 0, "Status-Server keepalive interval")
+	var dicts, attrs, pool, acctPool stringList
+	flag.Var(&pool, "pool", "authentication server as addr[,secret[,weight]], replaces -server (repeatable)")
+	flag.Var(&acctPool, "acct-pool", "accounting server as addr[,secret[,weight]] (repeatable, default the -pool hosts on port 1813)")
+	flag.Var(&dicts, "dict", "additional FreeRADIUS dictionary file (repeatable)")
+	flag.Var(&attrs, "attr", "extra request attribute as Name=value (repeatable)")
+	flag.Parse()
+	context.VlanID = uint32(*vlan)
+
+	dict, err := radius.LoadDictionary(dicts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var extra radius.Attributes
+	for _, attr := range attrs {
+		avps, err := dict.ParseAttribute(attr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		extra = append(extra, avps...)
+	}
+
+	if *status {
+		result, err := radius.Probe(*server, context.NasPasswd, 3*time.Second)
+		if err != nil {
+			log.Fatalf("Status-Server: %s", err)
+		}
+		log.Printf("%s in %s", result.Reply.Code, result.RTT)
+		for _, line := range dict.FormatAttributes(result.Reply.Attributes) {
+			log.Printf("  %s", line)
+		}
+		return
+	}
+
+	s := session.New(*server, context)
+	s.Strict = *strict
+	s.Verbose = *verbose
+	s.Dictionary = dict
+	s.Attributes = extra
+	if m, ok := session.ParseMethod(*method); ok {
+		s.Method = m
+	} else {
+		log.Fatalf("unknown method %q", *method)
+	}
+	s.Retransmit = &retransmit
+	var tlsConfig *tls.Config
+	authPort, acctPort := radius.AuthPort, "1813"
+	switch *transport {
+	case "udp", "tcp":
+	case "tls", "dtls":
+		tlsConfig, err = radius.NewTLSConfig(*certFile, *keyFile, *caFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// RadSec and DTLS servers take accounting on the same port.
+		authPort, acctPort = radius.RadSecPort, radius.RadSecPort
+		if len(acctPool) == 0 {
+			acctPool = pool
+		}
+	default:
+		log.Fatalf("unknown transport %q", *transport)
+	}
+	if *pcapFile != "" {
+		f, err := os.Create(*pcapFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		// pcapng has no secrets block for RADIUS, see NewPcapWriter: the
+		// shared secret for the radius.shared_secret preference of
+		// Wireshark is left in the section comment.
+		secret := context.NasPasswd
+		switch *transport {
+		case "tls":
+			secret = radius.RadSecSecret
+		case "dtls":
+			secret = radius.DTLSSecret
+		}
+		if s.Capture, err = radius.NewPcapWriter(f, "RADIUS shared secret: "+secret); err != nil {
+			log.Fatal(err)
+		}
+	}
+	switch {
+	case len(pool) > 0:
+		if len(acctPool) == 0 {
+			for _, spec := range pool {
+				fields := strings.SplitN(spec, ",", 2)
+				if host, _, err := net.SplitHostPort(fields[0]); err == nil {
+					fields[0] = host
+				}
+				acctPool = append(acctPool, strings.Join(fields, ","))
+			}
+		}
+		st, ok := radius.ParseStrategy(*strategy)
+		if !ok {
+			log.Fatalf("unknown strategy %q", *strategy)
+		}
+		s.Pool = newPool(pool, *transport, authPort, context.NasPasswd, tlsConfig)
+		s.AcctPool = newPool(acctPool, *transport, acctPort, context.NasPasswd, tlsConfig)
+		for _, p := range []*radius.Pool{s.Pool, s.AcctPool} {
+			p.Strategy, p.DeadTime, p.StatusServer = st, *deadTime, *statusCheck
+			p.Retransmit = &retransmit
+			p.Keepalive = *keepalive
+			p.Capture = s.Capture
+			p.Logf = log.Printf
+			defer p.Close()
+		}
+	case *transport != "udp":
+		c, err := radius.Dial(*transport, *server, context.NasPasswd, tlsConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer c.Close()
+		c.Retransmit = retransmit
+		c.Keepalive = *keepalive
+		c.Capture = s.Capture
+		s.Client = c
+		switch {
+		case *transport != "tcp":
+			s.AcctClient = c
+		case *acct:
+			// The host of AcctServerIP keeps the zone of a link-local
+			// server.
+			host, _, _ := net.SplitHostPort(s.AcctServerIP.String())
+			acctAddr := net.JoinHostPort(host, acctPort)
+			if s.AcctClient, err = radius.Dial(*transport, acctAddr, context.NasPasswd, tlsConfig); err != nil {
+				log.Fatal(err)
+			}
+			defer s.AcctClient.Close()
+			s.AcctClient.Retransmit = retransmit
+			s.AcctClient.Keepalive = *keepalive
+			s.AcctClient.Capture = s.Capture
+		}
+	}
+	s.Accounting = *acct
+	s.SendAccountingOn = *acctOn
+	s.InterimInterval = *interim
+	s.SessionTimeout = *duration
+	s.DynAuthAddr = *dynAuth
+	s.InputRate, s.OutputRate = *rate, *rate
+	s.Counters.InputOctets, s.Counters.OutputOctets = *octets, *octets
+	if *acct {
+		stop := make(chan struct{})
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			close(stop)
+		}()
+		s.Stop = stop
+	}
 	s.Run()
+	log.Printf("Result: %s", s.Result)
+	log.Printf("Stats: %+v", s.Statistics())
 }
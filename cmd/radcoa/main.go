@@ -0,0 +1,65 @@
+// Command radcoa sends a CoA-Request or Disconnect-Request to a NAS and
+// prints its ACK or NAK.
+//
+//	radcoa -nas 192.168.111.111 -secret sercet -disconnect -attr Acct-Session-Id=0001
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/sdir/eapol_test/radius"
+)
+
+func main() {
+	nas := flag.String("nas", "192.168.111.111", "NAS address, port 3799 by default")
+	secret := flag.String("secret", "sercet", "RADIUS shared secret")
+	disconnect := flag.Bool("disconnect", false, "send a Disconnect-Request instead of a CoA-Request")
+	msgAuth := flag.Bool("msgauth", true, "add Message-Authenticator as the first attribute")
+	timeout := flag.Duration("timeout", 3*time.Second, "time to wait for a reply before resending")
+	retries := flag.Int("retries", 2, "number of retransmissions")
+	var dicts, attrs []string
+	flag.Func("dict", "additional FreeRADIUS dictionary file (repeatable)", func(s string) error {
+		dicts = append(dicts, s)
+		return nil
+	})
+	flag.Func("attr", "request attribute as Name=value (repeatable)", func(s string) error {
+		attrs = append(attrs, s)
+		return nil
+	})
+	flag.Parse()
+
+	dict, err := radius.LoadDictionary(dicts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	req := &radius.Packet{Code: radius.CodeCoARequest}
+	if *disconnect {
+		req.Code = radius.CodeDisconnectRequest
+	}
+	for _, attr := range attrs {
+		avps, err := dict.ParseAttribute(attr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		req.Attributes = append(req.Attributes, avps...)
+	}
+	if *msgAuth {
+		req.MessageAuthenticator_Prepend(*secret)
+	}
+
+	log.Printf("%s to %s", req.Code, *nas)
+	reply, err := radius.SendDynAuth(*nas, req, *secret, *timeout, *retries)
+	if reply != nil {
+		log.Printf("Identifier:%d %s", reply.Identifier, reply.Code)
+		for _, line := range dict.FormatAttributes(reply.Attributes) {
+			log.Printf("  %s", line)
+		}
+	}
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,451 @@
+package eap
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var codeNames = map[string]uint64{
+	"Request":  uint64(EAPRequest),
+	"Response": uint64(EAPResponse),
+	"Success":  uint64(EAPSuccess),
+	"Failure":  uint64(EAPFailure),
+}
+
+var typeNames = map[string]uint64{
+	"Identity": uint64(Identity),
+	"Nak":      uint64(LegacyNak),
+	"PEAP":     uint64(Peap),
+	"MSCHAPv2": uint64(MsChapv2),
+	"TLV":      uint64(TLV),
+}
+
+var opCodeNames = map[string]uint64{
+	"Challenge":       uint64(MsChapV2Challenge),
+	"Response":        uint64(MsChapV2Response),
+	"Success":         uint64(MsChapV2Success),
+	"Failure":         uint64(MsChapV2Failure),
+	"Change-Password": uint64(MsChapV2ChangePwd),
+}
+
+var resultNames = map[string]uint64{
+	"Success": uint64(TLVResOk),
+	"Failure": uint64(TLVResFail),
+}
+
+// MarshalText returns the name of the code, or its number if it has none.
+func (code EapCode) MarshalText() ([]byte, error) {
+	return marshalName(codeNames, uint64(code)), nil
+}
+
+func (code *EapCode) UnmarshalText(text []byte) error {
+	n, err := unmarshalName(codeNames, text, 8)
+	if err != nil {
+		return err
+	}
+	*code = EapCode(n)
+	return nil
+}
+
+// MarshalText returns the name of the type, or its number if it has none.
+func (msgType EapType) MarshalText() ([]byte, error) {
+	return marshalName(typeNames, uint64(msgType)), nil
+}
+
+func (msgType *EapType) UnmarshalText(text []byte) error {
+	n, err := unmarshalName(typeNames, text, 8)
+	if err != nil {
+		return err
+	}
+	*msgType = EapType(n)
+	return nil
+}
+
+func (opCode MsChapV2OpCode) MarshalText() ([]byte, error) {
+	return marshalName(opCodeNames, uint64(opCode)), nil
+}
+
+func (opCode *MsChapV2OpCode) UnmarshalText(text []byte) error {
+	n, err := unmarshalName(opCodeNames, text, 8)
+	if err != nil {
+		return err
+	}
+	*opCode = MsChapV2OpCode(n)
+	return nil
+}
+
+func (result TLVResult) MarshalText() ([]byte, error) {
+	return marshalName(resultNames, uint64(result)), nil
+}
+
+func (result *TLVResult) UnmarshalText(text []byte) error {
+	n, err := unmarshalName(resultNames, text, 16)
+	if err != nil {
+		return err
+	}
+	*result = TLVResult(n)
+	return nil
+}
+
+func marshalName(names map[string]uint64, value uint64) []byte {
+	for name, v := range names {
+		if v == value {
+			return []byte(name)
+		}
+	}
+	return []byte(strconv.FormatUint(value, 10))
+}
+
+func unmarshalName(names map[string]uint64, text []byte, bits int) (uint64, error) {
+	if v, ok := names[string(text)]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, bits)
+	if err != nil {
+		return 0, fmt.Errorf("eap: unknown value %q", text)
+	}
+	return n, nil
+}
+
+// hexBytes are shown as a hex string.
+type hexBytes []byte
+
+func (b hexBytes) MarshalText() ([]byte, error) {
+	return []byte("0x" + hex.EncodeToString(b)), nil
+}
+
+func (b *hexBytes) UnmarshalText(text []byte) error {
+	data, err := hex.DecodeString(strings.TrimPrefix(string(text), "0x"))
+	if err != nil {
+		return err
+	}
+	*b = data
+	return nil
+}
+
+// eapJSON is the JSON and YAML form of every EAP message. Only the fields
+// of the method of the message are set.
+type eapJSON struct {
+	Code EapCode `json:"code" yaml:"code"`
+	Id   uint8   `json:"id" yaml:"id"`
+	Type EapType `json:"type,omitempty" yaml:"type,omitempty"`
+
+	Identity    string  `json:"identity,omitempty" yaml:"identity,omitempty"`
+	DesiredType EapType `json:"desired_type,omitempty" yaml:"desired_type,omitempty"`
+
+	Result TLVResult `json:"result,omitempty" yaml:"result,omitempty"`
+
+	OpCode  MsChapV2OpCode `json:"op_code,omitempty" yaml:"op_code,omitempty"`
+	MsId    uint8          `json:"ms_id,omitempty" yaml:"ms_id,omitempty"`
+	Value   hexBytes       `json:"value,omitempty" yaml:"value,omitempty"`
+	Name    string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Message string         `json:"message,omitempty" yaml:"message,omitempty"`
+
+	Flags      *flagsJSON `json:"flags,omitempty" yaml:"flags,omitempty"`
+	TLSLength  uint32     `json:"tls_length,omitempty" yaml:"tls_length,omitempty"`
+	TLSPayload hexBytes   `json:"tls_payload,omitempty" yaml:"tls_payload,omitempty"`
+
+	Data hexBytes `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+type flagsJSON struct {
+	Length   bool `json:"length,omitempty" yaml:"length,omitempty"`
+	MoreFrag bool `json:"more,omitempty" yaml:"more,omitempty"`
+	Start    bool `json:"start,omitempty" yaml:"start,omitempty"`
+	Version  byte `json:"version" yaml:"version"`
+}
+
+// jsonPacket is implemented by the EAP messages that can be encoded to JSON
+// and YAML.
+type jsonPacket interface {
+	EapPacket
+	toJSON() *eapJSON
+	fromJSON(v *eapJSON)
+}
+
+func (packet *HeaderEap) toJSON() *eapJSON {
+	return &eapJSON{Code: packet.code, Id: packet.id, Type: packet.msgType}
+}
+
+func (packet *HeaderEap) fromJSON(v *eapJSON) {
+	packet.code = v.Code
+	packet.id = v.Id
+	if v.Type != 0 {
+		packet.msgType = v.Type
+	}
+	packet.length = 4
+	if packet.code == EAPRequest || packet.code == EAPResponse {
+		packet.length = 5
+	}
+}
+
+func (packet *EapIdentity) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.Identity = packet.identity
+	return v
+}
+
+func (packet *EapIdentity) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	packet.identity = v.Identity
+}
+
+func (packet *EapNak) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.DesiredType = packet.desiredAuthType
+	return v
+}
+
+func (packet *EapNak) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	packet.desiredAuthType = v.DesiredType
+}
+
+func (packet *EapTLVResult) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.Result = packet.result
+	return v
+}
+
+func (packet *EapTLVResult) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	packet.result = v.Result
+}
+
+func (packet *EapMSCHAPv2) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.OpCode = packet.opCode
+	v.MsId = packet.msID
+	v.Value = packet.value
+	v.Name = packet.name
+	v.Message = packet.message
+	return v
+}
+
+func (packet *EapMSCHAPv2) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	packet.opCode = v.OpCode
+	packet.msID = v.MsId
+	packet.value = v.Value
+	packet.name = v.Name
+	packet.message = v.Message
+}
+
+func (packet *EapPeap) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.Flags = &flagsJSON{
+		Length:   packet.flags.length,
+		MoreFrag: packet.flags.moreFrag,
+		Start:    packet.flags.start,
+		Version:  packet.flags.version,
+	}
+	v.TLSLength = packet.tlsLength
+	v.TLSPayload = packet.tlsPayload
+	return v
+}
+
+func (packet *EapPeap) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	if v.Flags != nil {
+		packet.flags = PeapFlags{
+			length:   v.Flags.Length,
+			moreFrag: v.Flags.MoreFrag,
+			start:    v.Flags.Start,
+			version:  v.Flags.Version,
+		}
+	}
+	packet.tlsLength = v.TLSLength
+	packet.tlsPayload = v.TLSPayload
+}
+
+func (packet *EapRaw) toJSON() *eapJSON {
+	v := packet.header.toJSON()
+	v.Data = packet.data
+	return v
+}
+
+func (packet *EapRaw) fromJSON(v *eapJSON) {
+	packet.header.fromJSON(v)
+	packet.data = v.Data
+}
+
+func (packet *HeaderEap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *HeaderEap) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *HeaderEap) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *HeaderEap) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapIdentity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapIdentity) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapIdentity) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapIdentity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapNak) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapNak) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapNak) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapNak) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapTLVResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapTLVResult) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapTLVResult) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapTLVResult) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapMSCHAPv2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapMSCHAPv2) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapMSCHAPv2) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapMSCHAPv2) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapPeap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapPeap) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapPeap) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapPeap) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func (packet *EapRaw) MarshalJSON() ([]byte, error) {
+	return json.Marshal(packet.toJSON())
+}
+
+func (packet *EapRaw) UnmarshalJSON(buff []byte) error {
+	return unmarshalJSON(packet, buff)
+}
+
+func (packet *EapRaw) MarshalYAML() (interface{}, error) {
+	return packet.toJSON(), nil
+}
+
+func (packet *EapRaw) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(packet, unmarshal)
+}
+
+func unmarshalJSON(packet jsonPacket, buff []byte) error {
+	var v eapJSON
+	if err := json.Unmarshal(buff, &v); err != nil {
+		return err
+	}
+	packet.fromJSON(&v)
+	return nil
+}
+
+func unmarshalYAML(packet jsonPacket, unmarshal func(interface{}) error) error {
+	var v eapJSON
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	packet.fromJSON(&v)
+	return nil
+}
+
+// Message holds any EAP message for JSON and YAML encoding. When decoding,
+// the implementation of the message is chosen from its code and type, like
+// Decode does, with EapRaw for the methods without one and for the codes
+// other than Request and Response.
+type Message struct {
+	EapPacket
+}
+
+func (m Message) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.EapPacket)
+}
+
+func (m *Message) UnmarshalJSON(buff []byte) error {
+	var v eapJSON
+	if err := json.Unmarshal(buff, &v); err != nil {
+		return err
+	}
+	m.EapPacket = fromJSON(&v)
+	return nil
+}
+
+func (m Message) MarshalYAML() (interface{}, error) {
+	return m.EapPacket, nil
+}
+
+func (m *Message) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v eapJSON
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	m.EapPacket = fromJSON(&v)
+	return nil
+}
+
+func fromJSON(v *eapJSON) EapPacket {
+	var packet jsonPacket = &HeaderEap{}
+	switch {
+	case v.Code == EAPRequest || v.Code == EAPResponse:
+		packet = GetEAPByType(v.Type).(jsonPacket)
+	case v.Type != 0 || len(v.Data) > 0:
+		// Like Decode, other codes longer than the header keep their
+		// type data as is.
+		packet = NewEapRaw(v.Type)
+	}
+	packet.fromJSON(v)
+	return packet
+}
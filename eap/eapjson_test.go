@@ -0,0 +1,90 @@
+package eap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMessage_JSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		packet string
+		impl   string
+	}{
+		{name: "Identity", packet: "0201000801626f62", impl: "*eap.EapIdentity"},
+		{name: "empty Identity", packet: "0100000501", impl: "*eap.EapIdentity"},
+		{name: "Nak", packet: "02020006031a", impl: "*eap.EapNak"},
+		{name: "TLV Result", packet: "0103000b21800300020001", impl: "*eap.EapTLVResult"},
+		{
+			name:   "MSCHAPv2 Challenge",
+			packet: "0104001d1a0107001810000102030405060708090a0b0c0d0e0f737276",
+			impl:   "*eap.EapMSCHAPv2",
+		},
+		{name: "MSCHAPv2 Success", packet: "0105000c1a03070007533d31", impl: "*eap.EapMSCHAPv2"},
+		{name: "MSCHAPv2 Success response", packet: "020600061a03", impl: "*eap.EapMSCHAPv2"},
+		{name: "PEAP Start", packet: "010700061921", impl: "*eap.EapPeap"},
+		{name: "PEAP with length", packet: "0208000d198000000003aabbcc", impl: "*eap.EapPeap"},
+		{name: "unknown type", packet: "010900070d0102", impl: "*eap.EapRaw"},
+		{name: "Success", packet: "030a0004", impl: "*eap.HeaderEap"},
+		{name: "Failure", packet: "040b0004", impl: "*eap.HeaderEap"},
+		{name: "unknown code", packet: "0509000501", impl: "*eap.EapRaw"},
+		{name: "unknown code with data", packet: "090c000702aabb", impl: "*eap.EapRaw"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := hex.DecodeString(tt.packet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			packet := Decode(want, nil)
+			if impl := fmt.Sprintf("%T", packet); impl != tt.impl {
+				t.Errorf("Decode() = %s, want %s", impl, tt.impl)
+			}
+			if packet.GetId() != want[1] {
+				t.Errorf("GetId() = %d, want %d", packet.GetId(), want[1])
+			}
+			if _, b := packet.Encode(); !bytes.Equal(b, want) {
+				t.Errorf("Encode() = %x, want %x", b, want)
+			}
+
+			b, err := json.Marshal(Message{packet})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var m Message
+			if err := json.Unmarshal(b, &m); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", b, err)
+			}
+			if _, got := m.Encode(); !bytes.Equal(got, want) {
+				t.Errorf("JSON %s encodes to %x, want %x", b, got, want)
+			}
+
+			b, err = yaml.Marshal(Message{packet})
+			if err != nil {
+				t.Fatal(err)
+			}
+			m = Message{}
+			if err := yaml.Unmarshal(b, &m); err != nil {
+				t.Fatalf("yaml.Unmarshal(%s) error = %v", b, err)
+			}
+			if _, got := m.Encode(); !bytes.Equal(got, want) {
+				t.Errorf("YAML %s encodes to %x, want %x", b, got, want)
+			}
+		})
+	}
+}
+
+func TestEapNak_Encode(t *testing.T) {
+	packet := NewEapNak()
+	packet.SetCode(EAPResponse)
+	packet.SetId(7)
+	packet.SetDesiredType(Peap)
+	if _, b := packet.Encode(); hex.EncodeToString(b) != "020700060319" {
+		t.Errorf("Encode() = %x, want 020700060319", b)
+	}
+}
@@ -20,7 +20,17 @@ func NewEapNak() *EapNak {
 }
 
 func (packet *EapNak) Encode() (bool, []byte) {
-	return packet.header.Encode()
+
+	packet.header.setLength(6)
+
+	ok, buff := packet.header.Encode()
+
+	if ok {
+		buff[5] = byte(packet.desiredAuthType)
+	}
+
+	return ok, buff
+
 }
 
 func (packet *EapNak) Decode(buff []byte) bool {
@@ -52,3 +62,15 @@ func (packet *EapNak) GetType() EapType {
 func (packet *EapNak) GetDesiredType() EapType {
 	return packet.desiredAuthType
 }
+
+func (packet *EapNak) SetDesiredType(msgType EapType) {
+	packet.desiredAuthType = msgType
+}
+
+func (packet *EapNak) SetCode(code EapCode) {
+	packet.header.SetCode(code)
+}
+
+func (packet *EapNak) SetId(id uint8) {
+	packet.header.SetId(id)
+}
@@ -47,7 +47,7 @@ func GetEAPByType(msgType EapType) EapPacket {
 		return NewEapTLVResult()
 	}
 
-	return &HeaderEap{}
+	return NewEapRaw(msgType)
 }
 
 type HeaderEap struct {
@@ -70,7 +70,7 @@ func (packet *HeaderEap) Encode() (bool, []byte) {
 
 	binary.BigEndian.PutUint16(buff[2:], packet.length)
 
-	if packet.code == EAPRequest || packet.code == EAPResponse {
+	if packet.length > 4 {
 		buff[4] = uint8(packet.msgType)
 	}
 
@@ -82,6 +82,7 @@ func Decode(buff []byte, req EapPacket) (eapPacket EapPacket) {
 
 	eapHeader := HeaderEap{
 		code:   EapCode(buff[0]),
+		id:     uint8(buff[1]),
 		length: binary.BigEndian.Uint16(buff[2:]),
 	}
 
@@ -115,7 +116,13 @@ func Decode(buff []byte, req EapPacket) (eapPacket EapPacket) {
 		return &eapHeader
 	}
 
-	eapPacket = GetEAPByType(eapHeader.msgType)
+	// Only requests and responses carry a method, the type data of other
+	// codes is kept as is.
+	if eapHeader.code == EAPRequest || eapHeader.code == EAPResponse {
+		eapPacket = GetEAPByType(eapHeader.msgType)
+	} else {
+		eapPacket = NewEapRaw(eapHeader.msgType)
+	}
 	eapPacket.Decode(buff)
 
 	return eapPacket
@@ -139,7 +146,7 @@ func (packet *HeaderEap) Decode(buff []byte) bool {
 
 	packet.length = length
 
-	if len(buff) > 4 && (packet.code == EAPRequest || packet.code == EAPResponse) {
+	if len(buff) > 4 {
 		packet.msgType = EapType(buff[4])
 	}
 
@@ -0,0 +1,83 @@
+package eap
+
+// EapRaw is an EAP message of a method without its own implementation,
+// keeping the type data after the header as is.
+type EapRaw struct {
+	header HeaderEap
+	data   []byte
+}
+
+func NewEapRaw(msgType EapType) *EapRaw {
+
+	header := HeaderEap{
+		msgType: msgType,
+	}
+
+	raw := &EapRaw{
+		header: header,
+	}
+
+	return raw
+
+}
+
+func (packet *EapRaw) Encode() (bool, []byte) {
+
+	packet.header.setLength(uint16(5 + len(packet.data)))
+
+	ok, buff := packet.header.Encode()
+
+	if ok {
+		copy(buff[5:], packet.data)
+	}
+
+	return ok, buff
+
+}
+
+func (packet *EapRaw) Decode(buff []byte) bool {
+
+	ok := packet.header.Decode(buff)
+
+	if !ok || len(buff) < 5 {
+		return false
+	}
+
+	packet.data = make([]byte, len(buff)-5)
+
+	copy(packet.data, buff[5:])
+
+	return true
+
+}
+
+func (packet *EapRaw) GetId() uint8 {
+	return packet.header.GetId()
+}
+
+func (packet *EapRaw) GetCode() EapCode {
+	return packet.header.GetCode()
+}
+
+func (packet *EapRaw) GetType() EapType {
+	return packet.header.GetType()
+}
+
+func (packet *EapRaw) GetData() []byte {
+	retVal := make([]byte, len(packet.data))
+	copy(retVal, packet.data)
+	return retVal
+}
+
+func (packet *EapRaw) SetId(id uint8) {
+	packet.header.SetId(id)
+}
+
+func (packet *EapRaw) SetCode(code EapCode) {
+	packet.header.SetCode(code)
+}
+
+func (packet *EapRaw) SetData(data []byte) {
+	packet.data = make([]byte, len(data))
+	copy(packet.data, data)
+}
@@ -3,6 +3,13 @@ module github.com/sdir/eapol_test
 go 1.17
 
 require (
-	golang.org/x/crypto v0.0.0-20210920023735-84f357641f63
-	golang.org/x/text v0.3.7
+	github.com/pion/dtls/v2 v2.2.7
+	golang.org/x/crypto v0.8.0
+	golang.org/x/text v0.13.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/pion/logging v0.2.2 // indirect
+	github.com/pion/transport/v2 v2.2.1 // indirect
 )
@@ -1,6 +1,9 @@
 package radius
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Type is the RADIUS attribute type.
 type Type int
@@ -79,9 +82,10 @@ func (a *Attributes) Set(key Type, value Attribute) {
 	}
 }
 
+// encodeTo writes a to b, which must be AttributesEncodedLen(a) bytes long.
 func (a Attributes) encodeTo(b []byte) {
 	for _, attr := range a {
-		if attr.Type < 0 || 255 < attr.Type || len(attr.Attribute) > 253 {
+		if attr.Type < 0 || 255 < attr.Type {
 			continue
 		}
 		size := 1 + 1 + len(attr.Attribute)
@@ -94,6 +98,8 @@ func (a Attributes) encodeTo(b []byte) {
 
 // ParseAttributes parses the wire-encoded RADIUS attributes and returns a new
 // Attributes value. An error is returned if the buffer is malformed.
+// Extended attributes (types 241-246) are kept as they appear on the wire,
+// Attributes.Extended decodes them and reassembles fragments.
 func ParseAttributes(b []byte) (Attributes, error) {
 	var attrs Attributes
 
@@ -129,7 +135,7 @@ func AttributesEncodedLen(a Attributes) (int, error) {
 			continue
 		}
 		if len(attr.Attribute) > 253 {
-			return 0, errors.New("radius: attribute too large")
+			return 0, fmt.Errorf("radius: attribute %d too large (%d bytes), use a long extended attribute", attr.Type, len(attr.Attribute))
 		}
 		n += 1 + 1 + len(attr.Attribute)
 	}
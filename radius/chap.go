@@ -0,0 +1,70 @@
+package radius
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+)
+
+// CHAPPassword computes the RFC 2865 CHAP-Password value
+// ID + MD5(ID + password + challenge).
+func CHAPPassword(id byte, password string, challenge []byte) []byte {
+	h := md5.New()
+	h.Write([]byte{id})
+	h.Write([]byte(password))
+	h.Write(challenge)
+	return append([]byte{id}, h.Sum(nil)...)
+}
+
+// NewCHAPChallenge returns a random 16 byte CHAP challenge.
+func NewCHAPChallenge() ([]byte, error) {
+	challenge := make([]byte, 16)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// CHAPPassword_Set sets the CHAP-Password of p for password. When challenge
+// is nil the Request Authenticator of p is the challenge, otherwise it is
+// sent as CHAP-Challenge.
+func (p *Packet) CHAPPassword_Set(id byte, password string, challenge []byte) (err error) {
+	if challenge == nil {
+		challenge = p.Authenticator[:]
+		p.Del(CHAPChallenge_Type)
+	} else {
+		var a Attribute
+		a, err = NewBytes(challenge)
+		if err != nil {
+			return
+		}
+		p.Set(CHAPChallenge_Type, a)
+	}
+	a, err := NewBytes(CHAPPassword(id, password, challenge))
+	if err != nil {
+		return
+	}
+	p.Set(CHAPPassword_Type, a)
+	return
+}
+
+// CHAPPassword_Verify reports whether the CHAP-Password of p was computed
+// from password.
+func (p *Packet) CHAPPassword_Verify(password string) (err error) {
+	value, ok := p.Lookup(CHAPPassword_Type)
+	if !ok {
+		return ErrNoAttribute
+	}
+	if len(value) != 17 {
+		return errors.New("radius: invalid CHAP-Password length")
+	}
+	challenge, ok := p.Lookup(CHAPChallenge_Type)
+	if !ok {
+		challenge = p.Authenticator[:]
+	}
+	if subtle.ConstantTimeCompare(CHAPPassword(value[0], password, challenge), value) != 1 {
+		return errors.New("radius: CHAP-Password mismatch")
+	}
+	return
+}
@@ -0,0 +1,449 @@
+package radius
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// clientReadBuffer is the socket receive buffer size requested by NewClient.
+const clientReadBuffer = 4 << 20
+
+// ErrClientClosed is returned by Exchange once the Client is closed.
+var ErrClientClosed = errors.New("radius: client closed")
+
+// Client exchanges packets with one RADIUS server over a single socket: a
+// UDP socket for NewClient, a TCP or TLS connection for NewTCPClient and
+// NewTLSClient, or a DTLS association for NewDTLSClient. The 256
+// identifiers of the socket are shared by all concurrent exchanges: an
+// identifier is held until its exchange ends, and Exchange waits when all
+// of them are in use. Connections that the server closes are dialed again
+// by the next exchange.
+type Client struct {
+	// Secret is the shared secret of the server.
+	Secret string
+	// Retransmit controls the retransmission of unanswered requests,
+	// DefaultRetransmitPolicy by default.
+	Retransmit RetransmitPolicy
+	// Attempt, if set, is called before every transmission of a request
+	// with the transmission number, starting at 1, and the time Exchange
+	// will wait for a reply before the next one.
+	Attempt func(p *Packet, attempt int, timeout time.Duration)
+	// Keepalive, if set, is the interval of the Status-Server requests
+	// sent to check the server. The connection is closed when one is not
+	// answered within the interval.
+	Keepalive time.Duration
+	// Dropped, if set, is called from the read loop with every reply that
+	// does not answer an outstanding request or fails authentication.
+	Dropped func(data []byte, err error)
+	// Capture, if set, records every packet sent and received.
+	Capture *PcapWriter
+
+	// dial, if set, opens a new connection once the current one failed.
+	dial      func() (net.Conn, error)
+	stream    bool
+	maxLength int
+	ids       chan byte
+	keep      sync.Once
+	closing   sync.Once
+	done      chan struct{}
+	mu        sync.Mutex
+	conn      *clientConn
+	pending   map[byte]*exchange
+}
+
+type exchange struct {
+	req   *Packet
+	hooks *Hooks
+	reply chan *Packet
+}
+
+// Hooks are callbacks of a single exchange, given to Exchange in its context
+// by WithHooks, for callers sharing a Client or Pool with others.
+type Hooks struct {
+	// Verify, if set, is called from the read loop with every authentic
+	// reply to the request. A reply it returns an error for is dropped
+	// like a forged one, and the exchange keeps waiting for another.
+	Verify func(reply, req *Packet) error
+	// Attempt and Dropped, if set, replace those of the Client for the
+	// exchange. Dropped gets the replies carrying the Identifier of the
+	// request; the Client still gets those that answer no exchange.
+	Attempt func(p *Packet, attempt int, timeout time.Duration)
+	Dropped func(data []byte, err error)
+}
+
+type hooksKey struct{}
+
+// WithHooks returns a copy of ctx carrying the hooks h of the exchanges
+// made with it.
+func WithHooks(ctx context.Context, h *Hooks) context.Context {
+	return context.WithValue(ctx, hooksKey{}, h)
+}
+
+// hooksFrom returns the hooks carried by ctx, or empty ones.
+func hooksFrom(ctx context.Context) *Hooks {
+	if h, ok := ctx.Value(hooksKey{}).(*Hooks); ok && h != nil {
+		return h
+	}
+	return &Hooks{}
+}
+
+// clientConn is a connection of a Client.
+type clientConn struct {
+	net.Conn
+	reading bool
+	once    sync.Once
+	done    chan struct{}
+	err     error
+}
+
+// fail closes the connection after the error err, once.
+func (cn *clientConn) fail(err error) {
+	cn.once.Do(func() {
+		cn.err = &transportError{err}
+		close(cn.done)
+		cn.Close()
+	})
+}
+
+func (cn *clientConn) failed() bool {
+	select {
+	case <-cn.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// transportError is an error of the connection to the server, as opposed
+// to an invalid request.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+func (e *transportError) Unwrap() error { return e.err }
+
+// isTransportError reports whether err is a failure of the transport to the
+// server: no reply or a connection error.
+func isTransportError(err error) bool {
+	var te *transportError
+	return err == ErrTimeout || errors.As(err, &te)
+}
+
+// NewClient returns a Client sending to the server at addr over UDP.
+func NewClient(addr, secret string) (*Client, error) {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Bursts of replies to many concurrent exchanges overflow the default
+	// socket buffer. This is best effort, the system may cap the size.
+	conn.(*net.UDPConn).SetReadBuffer(clientReadBuffer)
+	return newClient(conn, secret), nil
+}
+
+func newClient(conn net.Conn, secret string) *Client {
+	c := &Client{
+		Secret:     secret,
+		Retransmit: DefaultRetransmitPolicy,
+		maxLength:  MaxPacketLength,
+		ids:        make(chan byte, 256),
+		done:       make(chan struct{}),
+		conn:       &clientConn{Conn: conn, done: make(chan struct{})},
+		pending:    make(map[byte]*exchange),
+	}
+	for i := 0; i < 256; i++ {
+		c.ids <- byte(i)
+	}
+	return c
+}
+
+// LocalAddr returns the local address of the client socket.
+func (c *Client) LocalAddr() net.Addr {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.LocalAddr()
+}
+
+// Close closes the socket. Outstanding exchanges fail with ErrClientClosed.
+func (c *Client) Close() error {
+	c.closing.Do(func() { close(c.done) })
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.conn.fail(ErrClientClosed)
+	return nil
+}
+
+// failed reports whether the client was closed or lost a connection it
+// cannot dial again.
+func (c *Client) failed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.dial == nil && c.conn.failed()
+}
+
+// connect returns the current connection, dialing a new one if it failed
+// and the transport allows it.
+func (c *Client) connect() (*clientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	select {
+	case <-c.done:
+		return nil, ErrClientClosed
+	default:
+	}
+	if c.conn.failed() {
+		if c.dial == nil {
+			return nil, c.conn.err
+		}
+		conn, err := c.dial()
+		if err != nil {
+			return nil, &transportError{err}
+		}
+		c.conn = &clientConn{Conn: conn, done: make(chan struct{})}
+	}
+	if !c.conn.reading {
+		c.conn.reading = true
+		go c.readLoop(c.conn)
+	}
+	return c.conn, nil
+}
+
+// Exchange sends the request p and returns its authentic reply. p gets a
+// free Identifier and is encoded, signing its Message-Authenticator and,
+// for accounting and dynamic authorization, its Request Authenticator.
+// The request is retransmitted following c.Retransmit. Exchange returns when
+// the reply arrives, the retransmissions are exhausted, ctx is done or the
+// client fails. The Hooks carried by ctx apply to this exchange only.
+func (c *Client) Exchange(ctx context.Context, p *Packet) (*Packet, error) {
+	if c.Keepalive > 0 {
+		c.keep.Do(func() { go c.keepalive() })
+	}
+	var id byte
+	select {
+	case id = <-c.ids:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, ErrClientClosed
+	}
+	defer func() { c.ids <- id }()
+
+	p.Identifier = id
+	b, err := p.encode(c.Secret, c.maxLength)
+	if err != nil {
+		return nil, err
+	}
+	ex := &exchange{req: p, hooks: hooksFrom(ctx), reply: make(chan *Packet, 1)}
+	c.mu.Lock()
+	c.pending[id] = ex
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	r := c.Retransmit
+	start := time.Now()
+	var rt time.Duration
+	resent := false
+	for attempt := 1; ; attempt++ {
+		cn, err := c.connect()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case c.stream:
+			// RFC 6613: requests are not retransmitted over
+			// a reliable transport, their reply is awaited for MRD,
+			// counted from the first transmission when the request
+			// is sent again on a new connection.
+			rt = r.MRD
+			if r.MRD > 0 {
+				if rt = r.MRD - time.Since(start); rt <= 0 {
+					return nil, ErrTimeout
+				}
+			}
+		case r.IRT > 0:
+			rt = r.timeout(rt)
+			if r.MRD > 0 {
+				if left := r.MRD - time.Since(start); rt > left {
+					rt = left
+				}
+			}
+		}
+		if ex.hooks.Attempt != nil {
+			ex.hooks.Attempt(p, attempt, rt)
+		} else if c.Attempt != nil {
+			c.Attempt(p, attempt, rt)
+		}
+		if _, err := cn.Write(b); err != nil {
+			cn.fail(err)
+		} else if c.Capture != nil {
+			c.Capture.WritePacket(time.Now(), cn.LocalAddr(), cn.RemoteAddr(), b)
+		}
+
+		reply, err := c.wait(ctx, ex, cn, rt)
+		switch {
+		case reply != nil:
+			return reply, nil
+		case err == errConnDone:
+			// RFC 6613: a request outstanding on a closed
+			// connection is sent again on a new one.
+			if c.dial == nil || resent {
+				return nil, cn.err
+			}
+			resent = true
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if c.stream || (r.MRC > 0 && attempt > r.MRC) || (r.MRD > 0 && time.Since(start) >= r.MRD) {
+			return nil, ErrTimeout
+		}
+	}
+}
+
+// errConnDone is returned by wait when the connection failed.
+var errConnDone = errors.New("radius: connection failed")
+
+// wait waits for the reply of ex on cn, for rt or without limit when rt is
+// zero. It returns neither a reply nor an error when rt elapsed.
+func (c *Client) wait(ctx context.Context, ex *exchange, cn *clientConn, rt time.Duration) (*Packet, error) {
+	var timeout <-chan time.Time
+	if rt > 0 {
+		timer := time.NewTimer(rt)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case reply := <-ex.reply:
+		return reply, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, ErrClientClosed
+	case <-cn.done:
+		return nil, errConnDone
+	case <-timeout:
+		return nil, nil
+	}
+}
+
+func (c *Client) readLoop(cn *clientConn) {
+	for {
+		var data []byte
+		var err error
+		if c.stream {
+			data, err = readStream(cn, c.maxLength)
+		} else {
+			data = make([]byte, c.maxLength)
+			var n int
+			n, err = cn.Read(data)
+			data = data[:n]
+		}
+		if err != nil {
+			cn.fail(err)
+			return
+		}
+		if c.Capture != nil {
+			c.Capture.WritePacket(time.Now(), cn.RemoteAddr(), cn.LocalAddr(), data)
+		}
+		ex, err := c.deliver(data)
+		switch {
+		case err == nil:
+		case ex != nil && ex.hooks.Dropped != nil:
+			ex.hooks.Dropped(data, err)
+		case c.Dropped != nil:
+			c.Dropped(data, err)
+		}
+	}
+}
+
+// keepalive sends a Status-Server every c.Keepalive until the client is
+// closed, closing the connection when one is not answered.
+func (c *Client) keepalive() {
+	ticker := time.NewTicker(c.Keepalive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), c.Keepalive)
+		_, err := c.Probe(ctx)
+		cancel()
+		if err != nil && err != ErrClientClosed {
+			c.mu.Lock()
+			c.conn.fail(err)
+			c.mu.Unlock()
+		}
+	}
+}
+
+// readStream reads a packet of at most max bytes framed by its Length
+// field from a stream.
+func readStream(r io.Reader, max int) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint16(header[2:4]))
+	if length < 20 || length > max {
+		// RFC 6613: the framing is lost, the connection
+		// must be closed.
+		return nil, errors.New("radius: invalid packet length")
+	}
+	data := make([]byte, length)
+	copy(data, header)
+	if _, err := io.ReadFull(r, data[4:]); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// deliver hands the reply in data to the exchange it answers. The exchange
+// matching its Identifier is returned with the error of a dropped reply.
+func (c *Client) deliver(data []byte) (*exchange, error) {
+	reply, err := parse(data, c.maxLength)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	ex, ok := c.pending[reply.Identifier]
+	c.mu.Unlock()
+	if !ok {
+		return nil, ErrIdentifierMismatch
+	}
+	if err := reply.verifyResponse(ex.req, c.Secret, c.maxLength); err != nil {
+		return ex, err
+	}
+	if _, ok := reply.Lookup(MessageAuthenticator_Type); ok {
+		if err := reply.verifyMessageAuthenticator(ex.req, c.Secret, c.maxLength); err != nil {
+			return ex, err
+		}
+	}
+	if ex.hooks.Verify != nil {
+		if err := ex.hooks.Verify(reply, ex.req); err != nil {
+			return ex, err
+		}
+	}
+	select {
+	case ex.reply <- reply:
+	default:
+		return ex, errors.New("radius: duplicate reply")
+	}
+	return ex, nil
+}
@@ -0,0 +1,350 @@
+package radius
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parse encodes the text form of a value of a. Integer attributes accept
+// their VALUE names, dates RFC 3339 times or seconds since the epoch,
+// prefixes CIDR notation, interface identifiers "xxxx:xxxx:xxxx:xxxx" and
+// octets a 0x prefixed hex string.
+func (a *DictAttribute) Parse(value string) (Attribute, error) {
+	if a.Encrypt != 0 {
+		return nil, fmt.Errorf("radius: %s is encrypted, use its typed setter", a.Name)
+	}
+	invalid := fmt.Errorf("radius: invalid %s value %q", a.Name, value)
+	switch a.DataType {
+	case DataTypeString:
+		return NewString(value)
+	case DataTypeInteger, DataTypeByte, DataTypeShort, DataTypeInteger64:
+		n, ok := a.ValueByName(value)
+		if !ok {
+			var err error
+			n, err = strconv.ParseUint(value, 0, 64)
+			if err != nil {
+				return nil, invalid
+			}
+		}
+		size := integerSizes[a.DataType]
+		if size < 8 && n >= 1<<(8*uint(size)) {
+			return nil, fmt.Errorf("radius: %s value %d out of range", a.Name, n)
+		}
+		return NewInteger64(n)[8-size:], nil
+	case DataTypeSigned:
+		n, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return nil, invalid
+		}
+		return NewSigned(int32(n)), nil
+	case DataTypeIPAddr:
+		return NewIPAddr(net.ParseIP(value))
+	case DataTypeIPv6Addr:
+		return NewIPv6Addr(net.ParseIP(value))
+	case DataTypeComboIP:
+		return NewComboIP(net.ParseIP(value))
+	case DataTypeIPv4Prefix, DataTypeIPv6Prefix:
+		_, prefix, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, invalid
+		}
+		if a.DataType == DataTypeIPv4Prefix {
+			return NewIPv4Prefix(prefix)
+		}
+		return NewIPv6Prefix(prefix)
+	case DataTypeIfID:
+		groups := strings.Split(value, ":")
+		if len(groups) != 4 {
+			return nil, invalid
+		}
+		id := make([]byte, 0, 8)
+		for _, group := range groups {
+			n, err := strconv.ParseUint(group, 16, 16)
+			if err != nil {
+				return nil, invalid
+			}
+			id = append(id, byte(n>>8), byte(n))
+		}
+		return NewIfID(id)
+	case DataTypeDate:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			n, err := strconv.ParseUint(value, 0, 32)
+			if err != nil {
+				return nil, invalid
+			}
+			return NewInteger(uint32(n)), nil
+		}
+		return NewDate(t)
+	case DataTypeEther:
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			return nil, err
+		}
+		return NewBytes(mac)
+	default:
+		if !strings.HasPrefix(value, "0x") {
+			if a.DataType == DataTypeOctets {
+				return NewBytes([]byte(value))
+			}
+			return nil, fmt.Errorf("radius: %s value must be 0x prefixed hex", a.Name)
+		}
+		b, err := hex.DecodeString(value[2:])
+		if err != nil {
+			return nil, err
+		}
+		return NewBytes(b)
+	}
+}
+
+// integerSizes are the lengths of the unsigned integer data types.
+var integerSizes = map[DataType]int{
+	DataTypeByte:      1,
+	DataTypeShort:     2,
+	DataTypeInteger:   4,
+	DataTypeInteger64: 8,
+}
+
+// Format returns the text form of a value of a, the reverse of Parse.
+// Values of an invalid length are shown as hex.
+func (a *DictAttribute) Format(value Attribute) string {
+	switch a.DataType {
+	case DataTypeString:
+		return strconv.Quote(string(value))
+	case DataTypeInteger, DataTypeByte, DataTypeShort, DataTypeInteger64:
+		if len(value) != integerSizes[a.DataType] {
+			break
+		}
+		n := uint64(0)
+		for _, c := range value {
+			n = n<<8 | uint64(c)
+		}
+		if name, ok := a.ValueName(n); ok {
+			return name
+		}
+		return strconv.FormatUint(n, 10)
+	case DataTypeSigned:
+		if n, err := Signed(value); err == nil {
+			return strconv.FormatInt(int64(n), 10)
+		}
+	case DataTypeIPAddr:
+		if ip, err := IPAddr(value); err == nil {
+			return ip.String()
+		}
+	case DataTypeIPv6Addr:
+		if ip, err := IPv6Addr(value); err == nil {
+			return ip.String()
+		}
+	case DataTypeComboIP:
+		if ip, err := ComboIP(value); err == nil {
+			return ip.String()
+		}
+	case DataTypeIPv4Prefix:
+		if prefix, err := IPv4Prefix(value); err == nil {
+			return prefix.String()
+		}
+	case DataTypeIPv6Prefix:
+		if prefix, err := IPv6Prefix(value); err == nil {
+			return prefix.String()
+		}
+	case DataTypeIfID:
+		if id, err := IfID(value); err == nil {
+			return fmt.Sprintf("%04x:%04x:%04x:%04x",
+				binary.BigEndian.Uint16(id[0:]), binary.BigEndian.Uint16(id[2:]),
+				binary.BigEndian.Uint16(id[4:]), binary.BigEndian.Uint16(id[6:]))
+		}
+	case DataTypeDate:
+		if t, err := Date(value); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	case DataTypeEther:
+		if len(value) == 6 {
+			return net.HardwareAddr(value).String()
+		}
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+// NewAVP builds the attribute called name with the text value. Tagged
+// attributes take the tag as a ":tag" name suffix, e.g.
+// "Tunnel-Private-Group-Id:1". Vendor attributes are wrapped in a
+// Vendor-Specific attribute. Values that need more than one attribute are
+// rejected, use NewAttributes for them.
+func (d *Dictionary) NewAVP(name, value string) (*AVP, error) {
+	attrs, err := d.NewAttributes(name, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(attrs) != 1 {
+		return nil, fmt.Errorf("radius: %s value needs %d fragments", name, len(attrs))
+	}
+	return attrs[0], nil
+}
+
+// NewAttributes is like NewAVP but also handles RFC 6929 extended
+// attributes, which are fragmented when they do not fit in one attribute.
+func (d *Dictionary) NewAttributes(name, value string) (Attributes, error) {
+	tag := -1
+	if i := strings.LastIndexByte(name, ':'); i >= 0 {
+		n, err := strconv.ParseUint(name[i+1:], 10, 8)
+		if err != nil || n > 0x1f {
+			return nil, fmt.Errorf("radius: invalid tag in %q", name)
+		}
+		name, tag = name[:i], int(n)
+	}
+	attr, ok := d.Attribute(name)
+	if !ok {
+		return nil, fmt.Errorf("radius: unknown attribute %q", name)
+	}
+	extended := attr.Vendor == 0 && len(attr.OID) == 2 && IsExtended(Type(attr.OID[0]))
+	if len(attr.OID) != 1 && !extended {
+		return nil, fmt.Errorf("radius: %s is not a top level attribute", attr.Name)
+	}
+	if tag >= 0 && !attr.HasTag {
+		return nil, fmt.Errorf("radius: %s does not take a tag", attr.Name)
+	}
+
+	a, err := attr.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	if attr.HasTag {
+		if tag < 0 {
+			tag = 0
+		}
+		a, err = JoinTag(byte(tag), a, attr.DataType == DataTypeInteger)
+		if err != nil {
+			return nil, fmt.Errorf("radius: %s: %s", attr.Name, err)
+		}
+	}
+
+	switch {
+	case extended:
+		return NewExtended(Type(attr.OID[0]), byte(attr.OID[1]), a)
+	case attr.Vendor == 0:
+		if len(a) > 253 {
+			return nil, errors.New("radius: attribute too large")
+		}
+		return Attributes{{Type: attr.Type(), Attribute: a}}, nil
+	}
+	vsa, err := d.NewVSA(attr.Vendor, attr.OID[0], a)
+	if err != nil {
+		return nil, err
+	}
+	return Attributes{{Type: VendorSpecific_Type, Attribute: vsa}}, nil
+}
+
+// ParseAttribute encodes an attribute given as Name=value, the form taken by
+// the -attr flag of the commands, with NewAttributes.
+func (d *Dictionary) ParseAttribute(s string) (Attributes, error) {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return nil, fmt.Errorf("radius: invalid attribute %q, want Name=value", s)
+	}
+	return d.NewAttributes(strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]))
+}
+
+// FormatAVP returns "Name = value" lines for avp, one per vendor
+// sub-attribute. Attributes missing from d are shown by number with a hex
+// value.
+func (d *Dictionary) FormatAVP(avp *AVP) []string {
+	if avp.Type == VendorSpecific_Type {
+		if vsas, err := d.ParseVSA(avp.Attribute); err == nil {
+			var lines []string
+			for _, v := range vsas {
+				if attr, ok := d.AttributeByOID(v.Vendor, v.Type); ok {
+					lines = append(lines, attr.Name+" = "+d.format(attr, v.Value))
+				} else {
+					lines = append(lines, fmt.Sprintf("Vendor-%d-Attr-%d = 0x%x", v.Vendor, v.Type, []byte(v.Value)))
+				}
+			}
+			return lines
+		}
+	}
+	if IsExtended(avp.Type) {
+		if ext, _, err := (Attributes{avp}).extendedAt(0); err == nil {
+			return []string{d.formatExtended(ext)}
+		}
+	}
+	attr, ok := d.AttributeByOID(0, uint32(avp.Type))
+	if !ok {
+		return []string{fmt.Sprintf("Attr-%d = 0x%x", avp.Type, []byte(avp.Attribute))}
+	}
+	return []string{attr.Name + " = " + d.format(attr, avp.Attribute)}
+}
+
+// FormatAttributes returns the "Name = value" lines of every attribute in a
+// like FormatAVP, showing fragmented long extended attributes reassembled.
+func (d *Dictionary) FormatAttributes(a Attributes) []string {
+	var lines []string
+	for i := 0; i < len(a); i++ {
+		if IsExtended(a[i].Type) {
+			if ext, last, err := a.extendedAt(i); err == nil {
+				lines = append(lines, d.formatExtended(ext))
+				i = last
+				continue
+			}
+		}
+		lines = append(lines, d.FormatAVP(a[i])...)
+	}
+	return lines
+}
+
+// formatExtended returns the "Name = value" line of an extended attribute.
+func (d *Dictionary) formatExtended(avp *ExtendedAVP) string {
+	attr, ok := d.AttributeByOID(0, uint32(avp.Type), uint32(avp.ExtendedType))
+	if !ok {
+		return fmt.Sprintf("Attr-%d.%d = 0x%x", avp.Type, avp.ExtendedType, []byte(avp.Value))
+	}
+	if attr.DataType == DataTypeEVS {
+		if vendor, vendorType, value, err := ParseEVS(avp.Value); err == nil {
+			return fmt.Sprintf("Attr-%d.%d.%d.%d = 0x%x", avp.Type, avp.ExtendedType, vendor, vendorType, []byte(value))
+		}
+	}
+	return attr.Name + " = " + d.format(attr, avp.Value)
+}
+
+// format formats value of attr, showing the elements of tlv attributes by
+// name as "{ Name = value, ... }".
+func (d *Dictionary) format(attr *DictAttribute, value Attribute) string {
+	if attr.DataType != DataTypeTLV {
+		return attr.formatTagged(value)
+	}
+	tlvs, err := ParseTLV(value)
+	if err != nil {
+		return attr.Format(value)
+	}
+	elems := make([]string, len(tlvs))
+	for i, tlv := range tlvs {
+		oid := append(append([]uint32(nil), attr.OID...), uint32(tlv.Type))
+		if child, ok := d.AttributeByOID(attr.Vendor, oid...); ok {
+			elems[i] = child.Name + " = " + d.format(child, tlv.Value)
+		} else {
+			elems[i] = fmt.Sprintf("Attr-%d = 0x%x", tlv.Type, []byte(tlv.Value))
+		}
+	}
+	return "{ " + strings.Join(elems, ", ") + " }"
+}
+
+// formatTagged formats value, showing the tag of has_tag attributes as a
+// ":tag" prefix.
+func (a *DictAttribute) formatTagged(value Attribute) string {
+	if !a.HasTag || len(value) == 0 {
+		return a.Format(value)
+	}
+	integer := a.DataType == DataTypeInteger
+	if integer && len(value) != 4 {
+		return a.Format(value)
+	}
+	tag, v := SplitTag(value, integer)
+	if tag == 0 && !integer {
+		return a.Format(v)
+	}
+	return fmt.Sprintf("%d:%s", tag, a.Format(v))
+}
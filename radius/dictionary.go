@@ -0,0 +1,495 @@
+package radius
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DataType is the data type of a dictionary attribute, named as in the
+// FreeRADIUS dictionary format.
+type DataType string
+
+const (
+	DataTypeString       DataType = "string"
+	DataTypeOctets       DataType = "octets"
+	DataTypeIPAddr       DataType = "ipaddr"
+	DataTypeInteger      DataType = "integer"
+	DataTypeDate         DataType = "date"
+	DataTypeIPv6Addr     DataType = "ipv6addr"
+	DataTypeIPv6Prefix   DataType = "ipv6prefix"
+	DataTypeIPv4Prefix   DataType = "ipv4prefix"
+	DataTypeIfID         DataType = "ifid"
+	DataTypeInteger64    DataType = "integer64"
+	DataTypeSigned       DataType = "signed"
+	DataTypeByte         DataType = "byte"
+	DataTypeShort        DataType = "short"
+	DataTypeEther        DataType = "ether"
+	DataTypeABinary      DataType = "abinary"
+	DataTypeComboIP      DataType = "combo-ip"
+	DataTypeTLV          DataType = "tlv"
+	DataTypeVSA          DataType = "vsa"
+	DataTypeExtended     DataType = "extended"
+	DataTypeLongExtended DataType = "long-extended"
+	DataTypeEVS          DataType = "evs"
+)
+
+var dataTypes = map[DataType]bool{
+	DataTypeString: true, DataTypeOctets: true, DataTypeIPAddr: true,
+	DataTypeInteger: true, DataTypeDate: true, DataTypeIPv6Addr: true,
+	DataTypeIPv6Prefix: true, DataTypeIPv4Prefix: true, DataTypeIfID: true,
+	DataTypeInteger64: true, DataTypeSigned: true, DataTypeByte: true,
+	DataTypeShort: true, DataTypeEther: true, DataTypeABinary: true,
+	DataTypeComboIP: true, DataTypeTLV: true, DataTypeVSA: true,
+	DataTypeExtended: true, DataTypeLongExtended: true, DataTypeEVS: true,
+}
+
+// DictAttribute is an ATTRIBUTE definition of a dictionary.
+type DictAttribute struct {
+	Name   string
+	Vendor uint32
+	// OID is the attribute number followed by the sub-types of extended and
+	// TLV attributes. For vendor attributes it is the vendor type.
+	OID      []uint32
+	DataType DataType
+	// Encrypt is the encrypt= flag: 1 for User-Password, 2 for salted
+	// (Tunnel-Password) and 3 for Ascend-Send-Secret encryption.
+	Encrypt int
+	HasTag  bool
+	Concat  bool
+	Array   bool
+	Values  []*DictValue
+}
+
+// DictValue is a VALUE definition, naming one value of an attribute.
+type DictValue struct {
+	Name  string
+	Value uint64
+}
+
+// DictVendor is a VENDOR definition.
+type DictVendor struct {
+	Name   string
+	ID     uint32
+	Format VendorFormat
+}
+
+// Dictionary holds attribute, value and vendor definitions parsed from
+// FreeRADIUS format dictionary files.
+type Dictionary struct {
+	Attributes []*DictAttribute
+	Vendors    []*DictVendor
+
+	attrByName   map[string]*DictAttribute
+	attrByOID    map[string]*DictAttribute
+	vendorByName map[string]*DictVendor
+	vendorByID   map[uint32]*DictVendor
+	pending      map[string][]*DictValue
+}
+
+// NewDictionary returns an empty Dictionary.
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		attrByName:   make(map[string]*DictAttribute),
+		attrByOID:    make(map[string]*DictAttribute),
+		vendorByName: make(map[string]*DictVendor),
+		vendorByID:   make(map[uint32]*DictVendor),
+		pending:      make(map[string][]*DictValue),
+	}
+}
+
+//go:generate go run ./internal/dictgen -o generated.go -manual CHAP-Password,Message-Authenticator dict/dictionary
+
+//go:embed dict
+var dictFS embed.FS
+
+var (
+	defaultDictOnce sync.Once
+	defaultDict     *Dictionary
+)
+
+// DefaultDictionary returns the dictionary embedded in the package. It
+// covers the standard attributes and the common vendors.
+func DefaultDictionary() *Dictionary {
+	defaultDictOnce.Do(func() {
+		d := NewDictionary()
+		err := d.parse("dict/dictionary", func(name string) (io.ReadCloser, error) {
+			return dictFS.Open(name)
+		}, func(base, name string) string {
+			return path.Join(path.Dir(base), name)
+		})
+		if err != nil {
+			panic(err)
+		}
+		defaultDict = d
+	})
+	return defaultDict
+}
+
+// LoadDictionary returns a copy of the default dictionary extended with the
+// given dictionary files.
+func LoadDictionary(files ...string) (*Dictionary, error) {
+	d := DefaultDictionary().Clone()
+	for _, file := range files {
+		if err := d.ParseFile(file); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Clone returns a copy of d that can be extended without modifying d.
+func (d *Dictionary) Clone() *Dictionary {
+	c := NewDictionary()
+	for _, v := range d.Vendors {
+		vendor := *v
+		c.addVendor(&vendor)
+	}
+	for _, a := range d.Attributes {
+		attr := *a
+		attr.Values = append([]*DictValue(nil), a.Values...)
+		c.addAttribute(&attr)
+	}
+	for name, values := range d.pending {
+		c.pending[name] = append([]*DictValue(nil), values...)
+	}
+	return c
+}
+
+// ParseFile parses the dictionary file at path. $INCLUDE directives are
+// resolved relative to the including file.
+func (d *Dictionary) ParseFile(path string) error {
+	return d.parse(path, func(name string) (io.ReadCloser, error) {
+		return os.Open(name)
+	}, func(base, name string) string {
+		if filepath.IsAbs(name) {
+			return name
+		}
+		return filepath.Join(filepath.Dir(base), name)
+	})
+}
+
+// Attribute returns the attribute called name, ignoring case.
+func (d *Dictionary) Attribute(name string) (*DictAttribute, bool) {
+	a, ok := d.attrByName[strings.ToLower(name)]
+	return a, ok
+}
+
+// AttributeByOID returns the attribute of vendor (0 for standard
+// attributes) with the given OID.
+func (d *Dictionary) AttributeByOID(vendor uint32, oid ...uint32) (*DictAttribute, bool) {
+	a, ok := d.attrByOID[oidKey(vendor, oid)]
+	return a, ok
+}
+
+// Vendor returns the vendor called name, ignoring case.
+func (d *Dictionary) Vendor(name string) (*DictVendor, bool) {
+	v, ok := d.vendorByName[strings.ToLower(name)]
+	return v, ok
+}
+
+// VendorByID returns the vendor with the given Private Enterprise Code.
+func (d *Dictionary) VendorByID(id uint32) (*DictVendor, bool) {
+	v, ok := d.vendorByID[id]
+	return v, ok
+}
+
+// Type returns the RADIUS attribute type of a, 26 for vendor attributes.
+func (a *DictAttribute) Type() Type {
+	if a.Vendor != 0 {
+		return VendorSpecific_Type
+	}
+	return Type(a.OID[0])
+}
+
+// ValueName returns the VALUE name of v.
+func (a *DictAttribute) ValueName(v uint64) (string, bool) {
+	for _, value := range a.Values {
+		if value.Value == v {
+			return value.Name, true
+		}
+	}
+	return "", false
+}
+
+// ValueByName returns the value called name, ignoring case.
+func (a *DictAttribute) ValueByName(name string) (uint64, bool) {
+	for _, value := range a.Values {
+		if strings.EqualFold(value.Name, name) {
+			return value.Value, true
+		}
+	}
+	return 0, false
+}
+
+func (d *Dictionary) addVendor(v *DictVendor) {
+	if old, ok := d.vendorByID[v.ID]; ok {
+		*old = *v
+		v = old
+	} else {
+		d.Vendors = append(d.Vendors, v)
+	}
+	d.vendorByName[strings.ToLower(v.Name)] = v
+	d.vendorByID[v.ID] = v
+}
+
+func (d *Dictionary) addAttribute(a *DictAttribute) {
+	key := oidKey(a.Vendor, a.OID)
+	if old, ok := d.attrByOID[key]; ok {
+		// A later definition replaces an earlier one but keeps its values.
+		if name := strings.ToLower(old.Name); d.attrByName[name] == old {
+			delete(d.attrByName, name)
+		}
+		a.Values = append(old.Values, a.Values...)
+		*old = *a
+		a = old
+	} else {
+		d.Attributes = append(d.Attributes, a)
+		d.attrByOID[key] = a
+	}
+	d.attrByName[strings.ToLower(a.Name)] = a
+	if values, ok := d.pending[strings.ToLower(a.Name)]; ok {
+		a.Values = append(a.Values, values...)
+		delete(d.pending, strings.ToLower(a.Name))
+	}
+}
+
+func oidKey(vendor uint32, oid []uint32) string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(uint64(vendor), 10))
+	for _, n := range oid {
+		b.WriteByte('.')
+		b.WriteString(strconv.FormatUint(uint64(n), 10))
+	}
+	return b.String()
+}
+
+type dictParser struct {
+	d      *Dictionary
+	open   func(name string) (io.ReadCloser, error)
+	join   func(base, name string) string
+	vendor *DictVendor
+	tlv    []*DictAttribute
+	depth  int
+}
+
+func (d *Dictionary) parse(name string, open func(string) (io.ReadCloser, error), join func(string, string) string) error {
+	p := &dictParser{d: d, open: open, join: join}
+	return p.parseFile(name)
+}
+
+func (p *dictParser) parseFile(name string) error {
+	if p.depth > 16 {
+		return fmt.Errorf("radius: %s: $INCLUDE nested too deeply", name)
+	}
+	f, err := p.open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	p.depth++
+	defer func() { p.depth-- }()
+
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := p.parseLine(name, fields); err != nil {
+			return fmt.Errorf("radius: %s:%d: %s", name, line, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (p *dictParser) parseLine(name string, fields []string) error {
+	switch fields[0] {
+	case "$INCLUDE", "$INCLUDE-":
+		if len(fields) != 2 {
+			return fmt.Errorf("invalid %s line", fields[0])
+		}
+		err := p.parseFile(p.join(name, fields[1]))
+		if err != nil && fields[0] == "$INCLUDE-" && os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	case "ATTRIBUTE":
+		return p.parseAttribute(fields[1:])
+	case "VALUE":
+		return p.parseValue(fields[1:])
+	case "VENDOR":
+		return p.parseVendor(fields[1:])
+	case "BEGIN-VENDOR":
+		if len(fields) < 2 {
+			return fmt.Errorf("invalid BEGIN-VENDOR line")
+		}
+		v, ok := p.d.Vendor(fields[1])
+		if !ok {
+			return fmt.Errorf("unknown vendor %q", fields[1])
+		}
+		p.vendor = v
+	case "END-VENDOR":
+		p.vendor = nil
+	case "BEGIN-TLV":
+		if len(fields) != 2 {
+			return fmt.Errorf("invalid BEGIN-TLV line")
+		}
+		a, ok := p.d.Attribute(fields[1])
+		if !ok || a.DataType != DataTypeTLV {
+			return fmt.Errorf("unknown tlv attribute %q", fields[1])
+		}
+		p.tlv = append(p.tlv, a)
+	case "END-TLV":
+		if len(p.tlv) == 0 {
+			return fmt.Errorf("END-TLV without BEGIN-TLV")
+		}
+		p.tlv = p.tlv[:len(p.tlv)-1]
+	case "ALIAS", "FLAGS", "PROTOCOL", "BEGIN-PROTOCOL", "END-PROTOCOL":
+		// Not needed by a client.
+	default:
+		return fmt.Errorf("unknown keyword %q", fields[0])
+	}
+	return nil
+}
+
+// parseAttribute parses "ATTRIBUTE name oid type [flags|vendor]".
+func (p *dictParser) parseAttribute(fields []string) error {
+	if len(fields) < 3 || len(fields) > 4 {
+		return fmt.Errorf("invalid ATTRIBUTE line")
+	}
+	a := &DictAttribute{Name: fields[0]}
+
+	oid, err := parseOID(fields[1])
+	if err != nil {
+		return err
+	}
+	if n := len(p.tlv); n > 0 {
+		parent := p.tlv[n-1]
+		a.Vendor = parent.Vendor
+		oid = append(append([]uint32(nil), parent.OID...), oid...)
+	} else if p.vendor != nil {
+		a.Vendor = p.vendor.ID
+	}
+	a.OID = oid
+
+	dataType := fields[2]
+	if i := strings.IndexByte(dataType, '['); i >= 0 {
+		dataType = dataType[:i]
+	}
+	a.DataType = DataType(dataType)
+	if !dataTypes[a.DataType] {
+		return fmt.Errorf("unknown data type %q", fields[2])
+	}
+
+	if len(fields) == 4 {
+		if v, ok := p.d.Vendor(fields[3]); ok {
+			a.Vendor = v.ID
+		} else if err := a.parseFlags(fields[3]); err != nil {
+			return err
+		}
+	}
+	if a.Vendor == 0 && len(a.OID) == 1 && a.OID[0] > 255 {
+		return fmt.Errorf("attribute number %d out of range", a.OID[0])
+	}
+	p.d.addAttribute(a)
+	return nil
+}
+
+func (a *DictAttribute) parseFlags(flags string) error {
+	for _, flag := range strings.Split(flags, ",") {
+		switch {
+		case flag == "has_tag":
+			a.HasTag = true
+		case flag == "concat":
+			a.Concat = true
+		case flag == "array":
+			a.Array = true
+		case strings.HasPrefix(flag, "encrypt="):
+			n, err := strconv.Atoi(strings.TrimPrefix(flag, "encrypt="))
+			if err != nil || n < 0 || n > 3 {
+				return fmt.Errorf("invalid flag %q", flag)
+			}
+			a.Encrypt = n
+		default:
+			// Flags such as "virtual" or "secret" do not change the
+			// wire format.
+		}
+	}
+	return nil
+}
+
+// parseValue parses "VALUE attribute name number".
+func (p *dictParser) parseValue(fields []string) error {
+	if len(fields) != 3 {
+		return fmt.Errorf("invalid VALUE line")
+	}
+	n, err := strconv.ParseUint(fields[2], 0, 64)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", fields[2])
+	}
+	value := &DictValue{Name: fields[1], Value: n}
+	if a, ok := p.d.Attribute(fields[0]); ok {
+		a.Values = append(a.Values, value)
+	} else {
+		// VALUE may precede its ATTRIBUTE.
+		key := strings.ToLower(fields[0])
+		p.d.pending[key] = append(p.d.pending[key], value)
+	}
+	return nil
+}
+
+// parseVendor parses "VENDOR name id [format=t,l]".
+func (p *dictParser) parseVendor(fields []string) error {
+	if len(fields) < 2 || len(fields) > 3 {
+		return fmt.Errorf("invalid VENDOR line")
+	}
+	id, err := strconv.ParseUint(fields[1], 0, 32)
+	if err != nil {
+		return fmt.Errorf("invalid vendor id %q", fields[1])
+	}
+	v := &DictVendor{Name: fields[0], ID: uint32(id), Format: DefaultVendorFormat}
+	if len(fields) == 3 {
+		if !strings.HasPrefix(fields[2], "format=") {
+			return fmt.Errorf("invalid vendor flag %q", fields[2])
+		}
+		parts := strings.Split(strings.TrimPrefix(fields[2], "format="), ",")
+		if len(parts) < 2 {
+			return fmt.Errorf("invalid vendor format %q", fields[2])
+		}
+		t, err1 := strconv.Atoi(parts[0])
+		l, err2 := strconv.Atoi(parts[1])
+		if err1 != nil || err2 != nil || (t != 1 && t != 2 && t != 4) || l < 0 || l > 2 {
+			return fmt.Errorf("invalid vendor format %q", fields[2])
+		}
+		v.Format = VendorFormat{TypeSize: t, LengthSize: l}
+	}
+	p.d.addVendor(v)
+	return nil
+}
+
+func parseOID(s string) ([]uint32, error) {
+	var oid []uint32
+	for _, part := range strings.Split(s, ".") {
+		n, err := strconv.ParseUint(part, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attribute number %q", s)
+		}
+		oid = append(oid, uint32(n))
+	}
+	return oid, nil
+}
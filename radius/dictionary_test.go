@@ -0,0 +1,181 @@
+package radius
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDictionary_ParseFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"dictionary": `# test dictionary
+$INCLUDE dictionary.vendor
+$INCLUDE- dictionary.missing
+VALUE	Test-Enum	Second	2
+ATTRIBUTE	Test-Enum	200	integer
+VALUE	Test-Enum	First	1
+ATTRIBUTE	Test-Secret	201	string	has_tag,encrypt=2
+`,
+		"dictionary.vendor": `VENDOR	Example	32473	format=2,1
+BEGIN-VENDOR	Example
+ATTRIBUTE	Example-Role	0x10	string
+END-VENDOR	Example
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d := NewDictionary()
+	if err := d.ParseFile(filepath.Join(dir, "dictionary")); err != nil {
+		t.Fatal(err)
+	}
+
+	enum, ok := d.Attribute("test-enum")
+	if !ok {
+		t.Fatal("Test-Enum not found")
+	}
+	if name, _ := enum.ValueName(2); name != "Second" {
+		t.Errorf("ValueName(2) = %q, want Second", name)
+	}
+	if v, _ := enum.ValueByName("first"); v != 1 {
+		t.Errorf("ValueByName(first) = %d, want 1", v)
+	}
+
+	secret, _ := d.Attribute("Test-Secret")
+	if secret == nil || !secret.HasTag || secret.Encrypt != 2 {
+		t.Errorf("Test-Secret flags = %+v", secret)
+	}
+
+	role, ok := d.AttributeByOID(32473, 16)
+	if !ok || role.Name != "Example-Role" {
+		t.Fatalf("AttributeByOID(32473, 16) = %v", role)
+	}
+	if f := d.VendorFormat(32473); f != (VendorFormat{TypeSize: 2, LengthSize: 1}) {
+		t.Errorf("VendorFormat() = %+v", f)
+	}
+	if f := LookupVendorFormat(32473); f != DefaultVendorFormat {
+		t.Errorf("LookupVendorFormat() = %+v after loading a dictionary", f)
+	}
+	avp, err := d.NewAVP("Example-Role", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := d.FormatAVP(avp); len(lines) != 1 || lines[0] != `Example-Role = "admin"` {
+		t.Errorf("FormatAVP() = %q", lines)
+	}
+}
+
+func TestDictionary_Redefine(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dictionary")
+	content := `ATTRIBUTE	Old-Name	200	integer
+VALUE	Old-Name	One	1
+ATTRIBUTE	New-Name	200	integer
+`
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	d := NewDictionary()
+	if err := d.ParseFile(file); err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := d.Attribute("Old-Name"); ok {
+		t.Errorf("renamed attribute still found as Old-Name: %s", a.Name)
+	}
+	a, ok := d.Attribute("New-Name")
+	if !ok {
+		t.Fatal("New-Name not found")
+	}
+	if name, _ := a.ValueName(1); name != "One" {
+		t.Errorf("ValueName(1) = %q, want One", name)
+	}
+}
+
+func TestDefaultDictionary(t *testing.T) {
+	d := DefaultDictionary()
+	tests := []struct {
+		name string
+		typ  Type
+	}{
+		{name: "User-Name", typ: UserName_Type},
+		{name: "EAP-Message", typ: EAPMessage_Type},
+		{name: "Tunnel-Password", typ: TunnelPassword_Type},
+		{name: "MS-MPPE-Send-Key", typ: VendorSpecific_Type},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, ok := d.Attribute(tt.name)
+			if !ok {
+				t.Fatalf("%s not found", tt.name)
+			}
+			if a.Type() != tt.typ {
+				t.Errorf("Type() = %d, want %d", a.Type(), tt.typ)
+			}
+		})
+	}
+}
+
+func TestDictionary_ParseAttribute(t *testing.T) {
+	d := DefaultDictionary()
+	attrs, err := d.ParseAttribute(" User-Name = bob ")
+	if err != nil || len(attrs) != 1 || attrs[0].Type != UserName_Type || string(attrs[0].Attribute) != "bob" {
+		t.Errorf("ParseAttribute() = %v, %v", attrs, err)
+	}
+	for _, s := range []string{"User-Name", "No-Such-Attribute=1"} {
+		if _, err := d.ParseAttribute(s); err == nil {
+			t.Errorf("ParseAttribute(%q) succeeded", s)
+		}
+	}
+}
+
+func TestDictionary_Dump(t *testing.T) {
+	p := New()
+	p.Identifier = 7
+	p.UserName_Set("bob")
+	p.FramedIPAddress_Set(net.IPv4(192, 0, 2, 1))
+	p.NASPortType_Set(NASPortType_Value_Ethernet)
+	// A PEAP Request with the L and M flags, split in two EAP-Message
+	// attributes.
+	eap := []byte{1, 5, 0, 0, 25, 0xc0, 0, 0, 0x0b, 0xb8}
+	eap = append(eap, make([]byte, 300)...)
+	binary.BigEndian.PutUint16(eap[2:], uint16(len(eap)))
+	p.EAPMessage_Set(eap)
+
+	var b strings.Builder
+	if err := DefaultDictionary().Dump(&b, p); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"Access-Request Id 7 length 351\n",
+		"\tAuthenticator = 0x",
+		"\tUser-Name = \"bob\"\n",
+		"\tFramed-IP-Address = 192.0.2.1\n",
+		"\tNAS-Port-Type = Ethernet\n",
+		"\t\tEAP Request Id 5 length 310 PEAP flags [L,M] version 0 TLS length 3000 fragment 300\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Dump missing %q in:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, "EAP Request"); n != 1 {
+		t.Errorf("EAP decoded %d times", n)
+	}
+
+	for eap, want := range map[string]string{
+		"\x02\x01\x00\x08\x01bob":  `EAP Response Id 1 length 8 Identity "bob"`,
+		"\x03\x02\x00\x04":         "EAP Success Id 2 length 4",
+		"\x01\x03\x00\x06\x19\x20": "EAP Request Id 3 length 6 PEAP flags [S] version 0 fragment 0",
+		"\x01\x03\x00\x09\x19\x20": "EAP Request Id 3 length 9 (6 received)",
+	} {
+		if got := FormatEAP([]byte(eap)); got != want {
+			t.Errorf("FormatEAP(%x) = %q, want %q", eap, got, want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package radius
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// eapCodeNames are the names of the EAP codes of RFC 3748 and RFC 6696.
+var eapCodeNames = map[byte]string{
+	1: "Request",
+	2: "Response",
+	3: "Success",
+	4: "Failure",
+	5: "Initiate",
+	6: "Finish",
+}
+
+// eapTypeNames are the names of the common EAP method types.
+var eapTypeNames = map[byte]string{
+	1:  "Identity",
+	2:  "Notification",
+	3:  "Nak",
+	4:  "MD5-Challenge",
+	5:  "OTP",
+	6:  "GTC",
+	13: "TLS",
+	17: "LEAP",
+	18: "SIM",
+	21: "TTLS",
+	23: "AKA",
+	25: "PEAP",
+	26: "MSCHAPv2",
+	33: "TLV",
+	43: "FAST",
+	50: "AKA'",
+	55: "TEAP",
+}
+
+// eapTLSTypes are the EAP types carrying TLS records after a flags octet
+// with the Length, More fragments and Start bits of RFC 5216.
+var eapTLSTypes = map[byte]bool{13: true, 21: true, 25: true, 43: true, 55: true}
+
+// Dump writes p in the style of radclient -x: a header line with the code,
+// Identifier, length and Authenticator, then a "Name = value" line for
+// every attribute named by d. The payload of the EAP-Message attributes is
+// decoded on the line following the last of them.
+func (d *Dictionary) Dump(w io.Writer, p *Packet) error {
+	length := 20
+	for _, avp := range p.Attributes {
+		length += 2 + len(avp.Attribute)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s Id %d length %d\n", p.Code, p.Identifier, length)
+	fmt.Fprintf(&b, "\tAuthenticator = 0x%x\n", p.Authenticator[:])
+	var eap []byte
+	last := -1
+	for i, avp := range p.Attributes {
+		if avp.Type == EAPMessage_Type {
+			eap = append(eap, avp.Attribute...)
+			last = i
+		}
+	}
+	for i := 0; i < len(p.Attributes); i++ {
+		a := p.Attributes[i:]
+		n := 1
+		if IsExtended(a[0].Type) {
+			if _, end, err := a.extendedAt(0); err == nil {
+				n = end + 1
+			}
+		}
+		for _, line := range d.FormatAttributes(a[:n]) {
+			fmt.Fprintf(&b, "\t%s\n", line)
+		}
+		if i+n-1 >= last && last >= 0 {
+			fmt.Fprintf(&b, "\t\t%s\n", FormatEAP(eap))
+			last = -1
+		}
+		i += n - 1
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// FormatEAP returns a one line description of the EAP packet b: its code,
+// Identifier, length and method type, followed for the TLS based methods
+// by the flags, the total TLS message length and the size of the fragment.
+func FormatEAP(b []byte) string {
+	if len(b) < 4 {
+		return fmt.Sprintf("EAP invalid 0x%x", b)
+	}
+	var s strings.Builder
+	s.WriteString("EAP ")
+	if name, ok := eapCodeNames[b[0]]; ok {
+		s.WriteString(name)
+	} else {
+		fmt.Fprintf(&s, "Code(%d)", b[0])
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	fmt.Fprintf(&s, " Id %d length %d", b[1], length)
+	if length != len(b) {
+		fmt.Fprintf(&s, " (%d received)", len(b))
+		if length > len(b) {
+			return s.String()
+		}
+	}
+	b = b[:length]
+	if (b[0] != 1 && b[0] != 2) || len(b) < 5 {
+		return s.String()
+	}
+
+	t := b[4]
+	if name, ok := eapTypeNames[t]; ok {
+		s.WriteString(" " + name)
+	} else {
+		fmt.Fprintf(&s, " Type(%d)", t)
+	}
+	data := b[5:]
+	switch {
+	case t == 1 || t == 2:
+		fmt.Fprintf(&s, " %q", data)
+	case t == 3:
+		fmt.Fprintf(&s, " desired %v", data)
+	case eapTLSTypes[t] && len(data) > 0:
+		flags, data := data[0], data[1:]
+		var names []string
+		for _, f := range []struct {
+			bit  byte
+			name string
+		}{{0x80, "L"}, {0x40, "M"}, {0x20, "S"}} {
+			if flags&f.bit != 0 {
+				names = append(names, f.name)
+			}
+		}
+		fmt.Fprintf(&s, " flags [%s] version %d", strings.Join(names, ","), flags&0x07)
+		if flags&0x80 != 0 && len(data) >= 4 {
+			fmt.Fprintf(&s, " TLS length %d", binary.BigEndian.Uint32(data))
+			data = data[4:]
+		}
+		fmt.Fprintf(&s, " fragment %d", len(data))
+	default:
+		fmt.Fprintf(&s, " 0x%x", data)
+	}
+	return s.String()
+}
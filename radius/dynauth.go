@@ -0,0 +1,77 @@
+package radius
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+)
+
+// DynAuthPort is the RFC 5176 port of CoA and Disconnect servers.
+const DynAuthPort = "3799"
+
+// NAKError is returned with a CoA-NAK or Disconnect-NAK reply.
+type NAKError struct {
+	Code  Code
+	Cause ErrorCause // zero if the NAK has no Error-Cause
+}
+
+func (e *NAKError) Error() string {
+	if e.Cause == 0 {
+		return "radius: " + e.Code.String()
+	}
+	return "radius: " + e.Code.String() + ": " + e.Cause.String()
+}
+
+// SendDynAuth sends the CoA-Request or Disconnect-Request req to the NAS at
+// addr, port 3799 if it has none, and returns the authentic ACK or NAK
+// answering it. req gets the Identifier of a new Client, which resends it
+// with the same Request Authenticator every timeout, give or take the RFC
+// 5080 random factor, up to retries times. A NAK is returned together with
+// a *NAKError.
+func SendDynAuth(addr string, req *Packet, secret string, timeout time.Duration, retries int) (*Packet, error) {
+	var ack, nak Code
+	switch req.Code {
+	case CodeCoARequest:
+		ack, nak = CodeCoAACK, CodeCoANAK
+	case CodeDisconnectRequest:
+		ack, nak = CodeDisconnectACK, CodeDisconnectNAK
+	default:
+		return nil, errors.New("radius: not a CoA-Request or Disconnect-Request")
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, DynAuthPort)
+	}
+	c, err := NewClient(addr, secret)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	// MRC alone bounds the retransmissions, since an MRD would cut the last
+	// ones short when the random factor lengthens the earlier timeouts.
+	c.Retransmit = RetransmitPolicy{IRT: timeout, MRC: retries, MRT: timeout}
+	if retries <= 0 {
+		// A zero MRC means no limit: MRD ends the exchange after the
+		// one transmission, whose timeout it caps below any random
+		// factor of IRT.
+		c.Retransmit = RetransmitPolicy{IRT: 2 * timeout, MRD: timeout}
+	}
+
+	ctx := WithHooks(context.Background(), &Hooks{
+		Verify: func(reply, req *Packet) error {
+			if reply.Code != ack && reply.Code != nak {
+				return errors.New("radius: unexpected " + reply.Code.String())
+			}
+			return nil
+		},
+	})
+	reply, err := c.Exchange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if reply.Code == nak {
+		cause, _ := reply.ErrorCause_Get()
+		return reply, &NAKError{Code: nak, Cause: cause}
+	}
+	return reply, nil
+}
@@ -0,0 +1,45 @@
+package radius
+
+func (p *Packet) EAPMessage_Set(value []byte) (err error) {
+	const maximumChunkSize = 253
+	var attrs []*AVP
+	for len(value) > 0 {
+		var a Attribute
+		n := len(value)
+		if n > maximumChunkSize {
+			n = maximumChunkSize
+		}
+		a, err = NewBytes(value[:n])
+		if err != nil {
+			return
+		}
+		attrs = append(attrs, &AVP{
+			Type:      EAPMessage_Type,
+			Attribute: a,
+		})
+		value = value[n:]
+	}
+	p.Attributes = append(p.Attributes, attrs...)
+	return
+}
+
+func (p *Packet) EAPMessage_Get() (value []byte, err error) {
+	var i []byte
+	var valid bool
+	for _, avp := range p.Attributes {
+		if avp.Type != EAPMessage_Type {
+			continue
+		}
+		attr := avp.Attribute
+		i = Bytes(attr)
+		if err != nil {
+			return
+		}
+		value = append(value, i...)
+		valid = true
+	}
+	if !valid {
+		err = ErrNoAttribute
+	}
+	return
+}
@@ -0,0 +1,207 @@
+package radius
+
+import "errors"
+
+// RFC 6929 limits: an Extended-Type attribute carries at most 252 bytes of
+// data, a Long-Extended-Type fragment at most 251.
+const (
+	maxExtendedLength     = 253 - 1
+	maxLongExtendedLength = 253 - 2
+
+	longExtendedMore = 0x80
+)
+
+// ExtendedAVP is a decoded RFC 6929 Extended-Type or Long-Extended-Type
+// attribute. The Value of a long extended attribute is reassembled from all
+// of its fragments.
+type ExtendedAVP struct {
+	Type         Type
+	ExtendedType byte
+	Value        Attribute
+}
+
+// IsExtended reports whether t is one of the Extended-Type (241-244) or
+// Long-Extended-Type (245-246) attribute types.
+func IsExtended(t Type) bool {
+	return t >= 241 && t <= 246
+}
+
+// IsLongExtended reports whether t is a Long-Extended-Type attribute type.
+func IsLongExtended(t Type) bool {
+	return t == 245 || t == 246
+}
+
+// Extended decodes the extended attributes of a in order, reassembling
+// fragmented long extended attributes.
+func (a Attributes) Extended() (avps []*ExtendedAVP, err error) {
+	for i := 0; i < len(a); i++ {
+		if !IsExtended(a[i].Type) {
+			continue
+		}
+		var avp *ExtendedAVP
+		avp, i, err = a.extendedAt(i)
+		if err != nil {
+			return
+		}
+		avps = append(avps, avp)
+	}
+	return
+}
+
+// extendedAt decodes the extended attribute starting at a[i] and returns the
+// index of its last fragment.
+func (a Attributes) extendedAt(i int) (*ExtendedAVP, int, error) {
+	first := a[i]
+	if len(first.Attribute) < 1 {
+		return nil, i, errors.New("radius: extended attribute without Extended-Type")
+	}
+	avp := &ExtendedAVP{
+		Type:         first.Type,
+		ExtendedType: first.Attribute[0],
+	}
+	if !IsLongExtended(first.Type) {
+		avp.Value = append(Attribute(nil), first.Attribute[1:]...)
+		return avp, i, nil
+	}
+
+	for ; i < len(a); i++ {
+		frag := a[i]
+		if frag.Type != avp.Type || len(frag.Attribute) < 2 || frag.Attribute[0] != avp.ExtendedType {
+			return nil, i, errors.New("radius: invalid long extended attribute fragment")
+		}
+		avp.Value = append(avp.Value, frag.Attribute[2:]...)
+		if frag.Attribute[1]&longExtendedMore == 0 {
+			return avp, i, nil
+		}
+	}
+	return nil, i, errors.New("radius: truncated long extended attribute")
+}
+
+// NewExtended encodes value as one or more attributes of the extended type
+// t. Long extended values longer than 251 bytes are fragmented with the
+// "More" flag.
+func NewExtended(t Type, extendedType byte, value []byte) (Attributes, error) {
+	if !IsExtended(t) {
+		return nil, errors.New("radius: not an extended attribute type")
+	}
+	if extendedType == 0 || extendedType == 255 {
+		return nil, errors.New("radius: reserved Extended-Type")
+	}
+	if !IsLongExtended(t) {
+		if len(value) > maxExtendedLength {
+			return nil, errors.New("radius: extended attribute too long, use a long extended type")
+		}
+		return Attributes{{
+			Type:      t,
+			Attribute: append(Attribute{extendedType}, value...),
+		}}, nil
+	}
+
+	var attrs Attributes
+	for {
+		n := len(value)
+		flags := byte(0)
+		if n > maxLongExtendedLength {
+			n = maxLongExtendedLength
+			flags = longExtendedMore
+		}
+		attr := make(Attribute, 2+n)
+		attr[0] = extendedType
+		attr[1] = flags
+		copy(attr[2:], value[:n])
+		attrs = append(attrs, &AVP{Type: t, Attribute: attr})
+		value = value[n:]
+		if len(value) == 0 {
+			return attrs, nil
+		}
+	}
+}
+
+// Extended_Gets returns the values of every extendedType attribute of the
+// extended type t in p.
+func (p *Packet) Extended_Gets(t Type, extendedType byte) (values []Attribute, err error) {
+	avps, err := p.Attributes.Extended()
+	if err != nil {
+		return
+	}
+	for _, avp := range avps {
+		if avp.Type == t && avp.ExtendedType == extendedType {
+			values = append(values, avp.Value)
+		}
+	}
+	return
+}
+
+// Extended_Lookup returns the value of the first extendedType attribute of
+// the extended type t in p. ok is false if there is none.
+func (p *Packet) Extended_Lookup(t Type, extendedType byte) (value Attribute, ok bool, err error) {
+	values, err := p.Extended_Gets(t, extendedType)
+	if err != nil || len(values) == 0 {
+		return
+	}
+	return values[0], true, nil
+}
+
+// Extended_Get returns the value of the first extendedType attribute of the
+// extended type t in p, or ErrNoAttribute.
+func (p *Packet) Extended_Get(t Type, extendedType byte) (value Attribute, err error) {
+	value, ok, err := p.Extended_Lookup(t, extendedType)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// Extended_Add appends value as an extendedType attribute of the extended
+// type t, fragmenting it if needed.
+func (p *Packet) Extended_Add(t Type, extendedType byte, value []byte) (err error) {
+	attrs, err := NewExtended(t, extendedType, value)
+	if err != nil {
+		return
+	}
+	p.Attributes = append(p.Attributes, attrs...)
+	return
+}
+
+// Extended_Set replaces every extendedType attribute of the extended type t
+// in p with value.
+func (p *Packet) Extended_Set(t Type, extendedType byte, value []byte) (err error) {
+	p.Extended_Del(t, extendedType)
+	return p.Extended_Add(t, extendedType, value)
+}
+
+// Extended_Del removes every extendedType attribute of the extended type t,
+// including all fragments, from p.
+func (p *Packet) Extended_Del(t Type, extendedType byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if avp.Type == t && len(avp.Attribute) > 0 && avp.Attribute[0] == extendedType {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// NewEVS encodes the value of an Extended-Vendor-Specific attribute
+// (Extended-Type 26): the vendor's Private Enterprise Code, the vendor type
+// and value.
+func NewEVS(vendor uint32, vendorType byte, value []byte) (Attribute, error) {
+	if vendor > 0xffffff {
+		return nil, errors.New("radius: invalid Vendor-Id")
+	}
+	a := make(Attribute, 5+len(value))
+	putUint(a[:4], vendor)
+	a[4] = vendorType
+	copy(a[5:], value)
+	return a, nil
+}
+
+// ParseEVS decodes the value of an Extended-Vendor-Specific attribute.
+func ParseEVS(a Attribute) (vendor uint32, vendorType byte, value Attribute, err error) {
+	if len(a) < 5 || a[0] != 0 {
+		err = errors.New("radius: invalid Extended-Vendor-Specific attribute")
+		return
+	}
+	return getUint(a[:4]), a[4], a[5:], nil
+}
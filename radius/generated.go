@@ -1,242 +1,10004 @@
+// Code generated by dictgen from dict/dictionary. DO NOT EDIT.
+
 package radius
 
 import (
-	"crypto/hmac"
-	"crypto/md5"
-	"encoding/binary"
-	"errors"
 	"fmt"
 	"net"
+	"strconv"
+	"time"
 )
 
+// Vendor identifiers.
 const (
-	UserName_Type        Type = 1
-	NASIPAddress_Type    Type = 4
-	FramedIPAddress_Type Type = 8
-	ServiceType_Type     Type = 6
-	FramedMTU_Type       Type = 12
-	EAPMessage_Type      Type = 79
-	State_Type           Type = 24
-
-	MessageAuthenticator_Type Type = 80
-
-	CallingStationID_Type Type = 31
-	NASPortType_Type      Type = 61
-	NASPortID_Type        Type = 87
+	VendorAruba     uint32 = 14823
+	VendorCisco     uint32 = 9
+	VendorHuawei    uint32 = 2011
+	VendorMicrosoft uint32 = 311
+	VendorUSR       uint32 = 429
 )
 
-type NASPortType uint32
-type ServiceType uint32
+// Attribute types.
+const (
+	UserName_Type                Type = 1
+	UserPassword_Type            Type = 2
+	CHAPPassword_Type            Type = 3
+	NASIPAddress_Type            Type = 4
+	NASPort_Type                 Type = 5
+	ServiceType_Type             Type = 6
+	FramedProtocol_Type          Type = 7
+	FramedIPAddress_Type         Type = 8
+	FramedIPNetmask_Type         Type = 9
+	FramedRouting_Type           Type = 10
+	FilterID_Type                Type = 11
+	FramedMTU_Type               Type = 12
+	FramedCompression_Type       Type = 13
+	LoginIPHost_Type             Type = 14
+	LoginService_Type            Type = 15
+	LoginTCPPort_Type            Type = 16
+	ReplyMessage_Type            Type = 18
+	CallbackNumber_Type          Type = 19
+	CallbackID_Type              Type = 20
+	FramedRoute_Type             Type = 22
+	FramedIPXNetwork_Type        Type = 23
+	State_Type                   Type = 24
+	Class_Type                   Type = 25
+	VendorSpecific_Type          Type = 26
+	SessionTimeout_Type          Type = 27
+	IdleTimeout_Type             Type = 28
+	TerminationAction_Type       Type = 29
+	CalledStationID_Type         Type = 30
+	CallingStationID_Type        Type = 31
+	NASIdentifier_Type           Type = 32
+	ProxyState_Type              Type = 33
+	LoginLATService_Type         Type = 34
+	LoginLATNode_Type            Type = 35
+	LoginLATGroup_Type           Type = 36
+	FramedAppleTalkLink_Type     Type = 37
+	FramedAppleTalkNetwork_Type  Type = 38
+	FramedAppleTalkZone_Type     Type = 39
+	CHAPChallenge_Type           Type = 60
+	NASPortType_Type             Type = 61
+	PortLimit_Type               Type = 62
+	LoginLATPort_Type            Type = 63
+	AcctStatusType_Type          Type = 40
+	AcctDelayTime_Type           Type = 41
+	AcctInputOctets_Type         Type = 42
+	AcctOutputOctets_Type        Type = 43
+	AcctSessionID_Type           Type = 44
+	AcctAuthentic_Type           Type = 45
+	AcctSessionTime_Type         Type = 46
+	AcctInputPackets_Type        Type = 47
+	AcctOutputPackets_Type       Type = 48
+	AcctTerminateCause_Type      Type = 49
+	AcctMultiSessionID_Type      Type = 50
+	AcctLinkCount_Type           Type = 51
+	TunnelType_Type              Type = 64
+	TunnelMediumType_Type        Type = 65
+	TunnelClientEndpoint_Type    Type = 66
+	TunnelServerEndpoint_Type    Type = 67
+	TunnelPassword_Type          Type = 69
+	TunnelPrivateGroupID_Type    Type = 81
+	TunnelAssignmentID_Type      Type = 82
+	TunnelPreference_Type        Type = 83
+	TunnelClientAuthID_Type      Type = 90
+	TunnelServerAuthID_Type      Type = 91
+	AcctInputGigawords_Type      Type = 52
+	AcctOutputGigawords_Type     Type = 53
+	EventTimestamp_Type          Type = 55
+	ARAPPassword_Type            Type = 70
+	ARAPFeatures_Type            Type = 71
+	ARAPZoneAccess_Type          Type = 72
+	ARAPSecurity_Type            Type = 73
+	ARAPSecurityData_Type        Type = 74
+	PasswordRetry_Type           Type = 75
+	Prompt_Type                  Type = 76
+	ConnectInfo_Type             Type = 77
+	ConfigurationToken_Type      Type = 78
+	EAPMessage_Type              Type = 79
+	MessageAuthenticator_Type    Type = 80
+	ARAPChallengeResponse_Type   Type = 84
+	AcctInterimInterval_Type     Type = 85
+	NASPortID_Type               Type = 87
+	FramedPool_Type              Type = 88
+	NASIPv6Address_Type          Type = 95
+	FramedInterfaceID_Type       Type = 96
+	FramedIPv6Prefix_Type        Type = 97
+	LoginIPv6Host_Type           Type = 98
+	FramedIPv6Route_Type         Type = 99
+	FramedIPv6Pool_Type          Type = 100
+	DelegatedIPv6Prefix_Type     Type = 123
+	ErrorCause_Type              Type = 101
+	FramedIPv6Address_Type       Type = 168
+	DNSServerIPv6Address_Type    Type = 169
+	RouteIPv6Information_Type    Type = 170
+	DelegatedIPv6PrefixPool_Type Type = 171
+	StatefulIPv6AddressPool_Type Type = 172
+	ExtendedAttribute1_Type      Type = 241
+	ExtendedAttribute2_Type      Type = 242
+	ExtendedAttribute3_Type      Type = 243
+	ExtendedAttribute4_Type      Type = 244
+	ExtendedAttribute5_Type      Type = 245
+	ExtendedAttribute6_Type      Type = 246
+)
 
+// Vendor attribute types.
 const (
-	NASPortType_Value_Ethernet NASPortType = 15
+	ArubaUserRole_VendorType              uint32 = 1
+	ArubaUserVlan_VendorType              uint32 = 2
+	ArubaPrivAdminUser_VendorType         uint32 = 3
+	ArubaAdminRole_VendorType             uint32 = 4
+	ArubaEssidName_VendorType             uint32 = 5
+	ArubaLocationID_VendorType            uint32 = 6
+	ArubaPortIdentifier_VendorType        uint32 = 7
+	ArubaMMSUserTemplate_VendorType       uint32 = 8
+	ArubaNamedUserVlan_VendorType         uint32 = 9
+	ArubaAPGroup_VendorType               uint32 = 10
+	ArubaFramedIPv6Address_VendorType     uint32 = 11
+	ArubaDeviceType_VendorType            uint32 = 12
+	CiscoAVPair_VendorType                uint32 = 1
+	CiscoNASPort_VendorType               uint32 = 2
+	CiscoDisconnectCause_VendorType       uint32 = 195
+	CiscoAccountInfo_VendorType           uint32 = 250
+	CiscoCommandCode_VendorType           uint32 = 252
+	HuaweiInputBurstSize_VendorType       uint32 = 1
+	HuaweiInputAverageRate_VendorType     uint32 = 2
+	HuaweiInputPeakRate_VendorType        uint32 = 3
+	HuaweiOutputBurstSize_VendorType      uint32 = 4
+	HuaweiOutputAverageRate_VendorType    uint32 = 5
+	HuaweiOutputPeakRate_VendorType       uint32 = 6
+	HuaweiConnectID_VendorType            uint32 = 26
+	HuaweiPortalURL_VendorType            uint32 = 27
+	HuaweiExecPrivilege_VendorType        uint32 = 29
+	HuaweiDomainName_VendorType           uint32 = 138
+	MSCHAPResponse_VendorType             uint32 = 1
+	MSCHAPError_VendorType                uint32 = 2
+	MSCHAPCPW1_VendorType                 uint32 = 3
+	MSCHAPCPW2_VendorType                 uint32 = 4
+	MSCHAPLMEncPW_VendorType              uint32 = 5
+	MSCHAPNTEncPW_VendorType              uint32 = 6
+	MSMPPEEncryptionPolicy_VendorType     uint32 = 7
+	MSMPPEEncryptionTypes_VendorType      uint32 = 8
+	MSRASVendor_VendorType                uint32 = 9
+	MSCHAPDomain_VendorType               uint32 = 10
+	MSCHAPChallenge_VendorType            uint32 = 11
+	MSCHAPMPPEKeys_VendorType             uint32 = 12
+	MSBAPUsage_VendorType                 uint32 = 13
+	MSLinkUtilizationThreshold_VendorType uint32 = 14
+	MSLinkDropTimeLimit_VendorType        uint32 = 15
+	MSMPPESendKey_VendorType              uint32 = 16
+	MSMPPERecvKey_VendorType              uint32 = 17
+	MSRASVersion_VendorType               uint32 = 18
+	MSOldARAPPassword_VendorType          uint32 = 19
+	MSNewARAPPassword_VendorType          uint32 = 20
+	MSARAPPWChangeReason_VendorType       uint32 = 21
+	MSFilter_VendorType                   uint32 = 22
+	MSAcctAuthType_VendorType             uint32 = 23
+	MSAcctEAPType_VendorType              uint32 = 24
+	MSCHAP2Response_VendorType            uint32 = 25
+	MSCHAP2Success_VendorType             uint32 = 26
+	MSCHAP2CPW_VendorType                 uint32 = 27
+	MSPrimaryDNSServer_VendorType         uint32 = 28
+	MSSecondaryDNSServer_VendorType       uint32 = 29
+	MSPrimaryNBNSServer_VendorType        uint32 = 30
+	MSSecondaryNBNSServer_VendorType      uint32 = 31
+	USRLastNumberDialedOut_VendorType     uint32 = 102
+	USRLastNumberDialedInDNIS_VendorType  uint32 = 232
 )
+
+// Extended attribute types.
 const (
-	ServiceType_Value_FramedUser ServiceType = 2
+	FragStatus_ExtendedType       byte = 1
+	ProxyStateLength_ExtendedType byte = 2
+	ResponseLength_ExtendedType   byte = 3
 )
 
-var ErrNoAttribute = errors.New("radius: attribute not found")
+// UserName_Get returns the first User-Name of p, or ErrNoAttribute.
+func (p *Packet) UserName_Get() (value string, err error) {
+	value, ok, err := p.UserName_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// UserName_Gets returns every User-Name of p.
+func (p *Packet) UserName_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != UserName_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// UserName_Lookup returns the first User-Name of p. ok is false if there is none.
+func (p *Packet) UserName_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(UserName_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
 
-// NewInteger creates a new Attribute from the given integer value.
-func NewInteger(i uint32) Attribute {
-	v := make([]byte, 4)
-	binary.BigEndian.PutUint32(v, i)
-	return v
+// UserName_Set replaces every User-Name of p with value.
+func (p *Packet) UserName_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: User-Name: %w", err)
+		return
+	}
+	p.Attributes.Set(UserName_Type, a)
+	return
 }
 
-// NewString returns a new Attribute from the given string. An error is returned
-// if the string length is greater than 253.
-func NewString(s string) (Attribute, error) {
-	if len(s) > 253 {
-		return nil, errors.New("string too long")
+// UserName_Add appends value as a User-Name to p.
+func (p *Packet) UserName_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: User-Name: %w", err)
+		return
 	}
-	return Attribute(s), nil
+	p.Attributes.Add(UserName_Type, a)
+	return
 }
 
-// Bytes returns the given Attribute as a byte slice.
-func Bytes(a Attribute) []byte {
-	b := make([]byte, len(a))
-	copy(b, a)
-	return b
+// UserName_Del removes every User-Name from p.
+func (p *Packet) UserName_Del() {
+	p.Attributes.Del(UserName_Type)
 }
 
-// NewBytes returns a new Attribute from the given byte slice. An error is
-// returned if the slice is longer than 253.
-func NewBytes(b []byte) (Attribute, error) {
-	if len(b) > 253 {
-		return nil, errors.New("value too long")
+// NASIPAddress_Get returns the first NAS-IP-Address of p, or ErrNoAttribute.
+func (p *Packet) NASIPAddress_Get() (value net.IP, err error) {
+	value, ok, err := p.NASIPAddress_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
 	}
-	a := make(Attribute, len(b))
-	copy(a, b)
-	return a, nil
+	return
 }
 
-// NewIPAddr returns a new Attribute from the given IP address. An error is
-// returned if the given address is not an IPv4 address.
-func NewIPAddr(a net.IP) (Attribute, error) {
-	a = a.To4()
-	if a == nil {
-		return nil, errors.New("invalid IPv4 address")
+// NASIPAddress_Gets returns every NAS-IP-Address of p.
+func (p *Packet) NASIPAddress_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASIPAddress_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: NAS-IP-Address: %w", err)
+			return
+		}
+		values = append(values, value)
 	}
-	b := make(Attribute, len(a))
-	copy(b, a)
-	return b, nil
+	return
 }
 
-func (p *Packet) SetUserName(user string) {
-	a, err := NewString(user)
+// NASIPAddress_Lookup returns the first NAS-IP-Address of p. ok is false if there is none.
+func (p *Packet) NASIPAddress_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASIPAddress_Type)
+	if !ok {
+		return
+	}
+	value, err = IPAddr(a)
 	if err != nil {
+		err = fmt.Errorf("radius: NAS-IP-Address: %w", err)
 		return
 	}
-	p.Attributes.Set(UserName_Type, a)
+	return
 }
 
-func (p *Packet) NASIPAddress_Add(addr string) {
-	a, err := NewIPAddr(net.ParseIP(addr))
+// NASIPAddress_Set replaces every NAS-IP-Address of p with value.
+func (p *Packet) NASIPAddress_Set(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
 	if err != nil {
+		err = fmt.Errorf("radius: NAS-IP-Address: %w", err)
 		return
 	}
-	p.Add(NASIPAddress_Type, a)
+	p.Attributes.Set(NASIPAddress_Type, a)
+	return
 }
 
-func (p *Packet) FramedIPAddress_Add(addr string) (err error) {
-	a, err := NewIPAddr(net.ParseIP(addr))
+// NASIPAddress_Add appends value as a NAS-IP-Address to p.
+func (p *Packet) NASIPAddress_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
 	if err != nil {
+		err = fmt.Errorf("radius: NAS-IP-Address: %w", err)
 		return
 	}
-	p.Add(FramedIPAddress_Type, a)
+	p.Attributes.Add(NASIPAddress_Type, a)
 	return
 }
 
-func (p *Packet) FramedMTU_Add(value uint32) (err error) {
-	a := NewInteger(value)
-	p.Add(FramedMTU_Type, a)
+// NASIPAddress_Del removes every NAS-IP-Address from p.
+func (p *Packet) NASIPAddress_Del() {
+	p.Attributes.Del(NASIPAddress_Type)
+}
+
+// NASPort_Get returns the first NAS-Port of p, or ErrNoAttribute.
+func (p *Packet) NASPort_Get() (value uint32, err error) {
+	value, ok, err := p.NASPort_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// NASPort_Gets returns every NAS-Port of p.
+func (p *Packet) NASPort_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASPort_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: NAS-Port: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
 	return
 }
 
-func (p *Packet) NASPortID_Add(switchPort string, vlanid uint32) {
-	ID := fmt.Sprintf("slot=%d;subslot=%d;port=%d;vlanid=%d;interfaceName=%s", 0, 0, 0, vlanid, switchPort)
-	a, err := NewString(ID)
+// NASPort_Lookup returns the first NAS-Port of p. ok is false if there is none.
+func (p *Packet) NASPort_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASPort_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
 	if err != nil {
+		err = fmt.Errorf("radius: NAS-Port: %w", err)
 		return
 	}
-	p.Attributes.Set(NASPortID_Type, a)
+	return
+}
+
+// NASPort_Set replaces every NAS-Port of p with value.
+func (p *Packet) NASPort_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(NASPort_Type, a)
+	return
+}
+
+// NASPort_Add appends value as a NAS-Port to p.
+func (p *Packet) NASPort_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(NASPort_Type, a)
+	return
+}
+
+// NASPort_Del removes every NAS-Port from p.
+func (p *Packet) NASPort_Del() {
+	p.Attributes.Del(NASPort_Type)
+}
+
+type ServiceType uint32
+
+const (
+	ServiceType_Value_LoginUser              ServiceType = 1
+	ServiceType_Value_FramedUser             ServiceType = 2
+	ServiceType_Value_CallbackLoginUser      ServiceType = 3
+	ServiceType_Value_CallbackFramedUser     ServiceType = 4
+	ServiceType_Value_OutboundUser           ServiceType = 5
+	ServiceType_Value_AdministrativeUser     ServiceType = 6
+	ServiceType_Value_NASPromptUser          ServiceType = 7
+	ServiceType_Value_AuthenticateOnly       ServiceType = 8
+	ServiceType_Value_CallbackNASPrompt      ServiceType = 9
+	ServiceType_Value_CallCheck              ServiceType = 10
+	ServiceType_Value_CallbackAdministrative ServiceType = 11
+)
+
+var ServiceType_Strings = map[ServiceType]string{
+	ServiceType_Value_LoginUser:              "Login-User",
+	ServiceType_Value_FramedUser:             "Framed-User",
+	ServiceType_Value_CallbackLoginUser:      "Callback-Login-User",
+	ServiceType_Value_CallbackFramedUser:     "Callback-Framed-User",
+	ServiceType_Value_OutboundUser:           "Outbound-User",
+	ServiceType_Value_AdministrativeUser:     "Administrative-User",
+	ServiceType_Value_NASPromptUser:          "NAS-Prompt-User",
+	ServiceType_Value_AuthenticateOnly:       "Authenticate-Only",
+	ServiceType_Value_CallbackNASPrompt:      "Callback-NAS-Prompt",
+	ServiceType_Value_CallCheck:              "Call-Check",
+	ServiceType_Value_CallbackAdministrative: "Callback-Administrative",
+}
+
+func (a ServiceType) String() string {
+	if str, ok := ServiceType_Strings[a]; ok {
+		return str
+	}
+	return "ServiceType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// ServiceType_Get returns the first Service-Type of p, or ErrNoAttribute.
+func (p *Packet) ServiceType_Get() (value ServiceType, err error) {
+	value, ok, err := p.ServiceType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ServiceType_Gets returns every Service-Type of p.
+func (p *Packet) ServiceType_Gets() (values []ServiceType, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ServiceType_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value ServiceType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Service-Type: %w", err)
+			return
+		}
+		value = ServiceType(i)
+		values = append(values, value)
+	}
+	return
 }
 
-func (p *Packet) CallingStationID_Add(mac string) {
-	a, err := NewString(mac)
+// ServiceType_Lookup returns the first Service-Type of p. ok is false if there is none.
+func (p *Packet) ServiceType_Lookup() (value ServiceType, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ServiceType_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
 	if err != nil {
+		err = fmt.Errorf("radius: Service-Type: %w", err)
 		return
 	}
-	p.Attributes.Set(CallingStationID_Type, a)
+	value = ServiceType(i)
+	return
 }
 
-func (p *Packet) NASPortType_Add(value NASPortType) (err error) {
+// ServiceType_Set replaces every Service-Type of p with value.
+func (p *Packet) ServiceType_Set(value ServiceType) (err error) {
 	a := NewInteger(uint32(value))
-	p.Add(NASPortType_Type, a)
+	p.Attributes.Set(ServiceType_Type, a)
 	return
 }
 
+// ServiceType_Add appends value as a Service-Type to p.
 func (p *Packet) ServiceType_Add(value ServiceType) (err error) {
 	a := NewInteger(uint32(value))
-	p.Add(ServiceType_Type, a)
+	p.Attributes.Add(ServiceType_Type, a)
+	return
+}
+
+// ServiceType_Del removes every Service-Type from p.
+func (p *Packet) ServiceType_Del() {
+	p.Attributes.Del(ServiceType_Type)
+}
+
+type FramedProtocol uint32
+
+const (
+	FramedProtocol_Value_PPP             FramedProtocol = 1
+	FramedProtocol_Value_SLIP            FramedProtocol = 2
+	FramedProtocol_Value_ARAP            FramedProtocol = 3
+	FramedProtocol_Value_GandalfSLML     FramedProtocol = 4
+	FramedProtocol_Value_XylogicsIPXSLIP FramedProtocol = 5
+	FramedProtocol_Value_X75Synchronous  FramedProtocol = 6
+)
+
+var FramedProtocol_Strings = map[FramedProtocol]string{
+	FramedProtocol_Value_PPP:             "PPP",
+	FramedProtocol_Value_SLIP:            "SLIP",
+	FramedProtocol_Value_ARAP:            "ARAP",
+	FramedProtocol_Value_GandalfSLML:     "Gandalf-SLML",
+	FramedProtocol_Value_XylogicsIPXSLIP: "Xylogics-IPX-SLIP",
+	FramedProtocol_Value_X75Synchronous:  "X.75-Synchronous",
+}
+
+func (a FramedProtocol) String() string {
+	if str, ok := FramedProtocol_Strings[a]; ok {
+		return str
+	}
+	return "FramedProtocol(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// FramedProtocol_Get returns the first Framed-Protocol of p, or ErrNoAttribute.
+func (p *Packet) FramedProtocol_Get() (value FramedProtocol, err error) {
+	value, ok, err := p.FramedProtocol_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
 	return
 }
 
-func (p *Packet) EAPMessage_Set(value []byte) (err error) {
-	const maximumChunkSize = 253
-	var attrs []*AVP
-	for len(value) > 0 {
-		var a Attribute
-		n := len(value)
-		if n > maximumChunkSize {
-			n = maximumChunkSize
+// FramedProtocol_Gets returns every Framed-Protocol of p.
+func (p *Packet) FramedProtocol_Gets() (values []FramedProtocol, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedProtocol_Type {
+			continue
 		}
-		a, err = NewBytes(value[:n])
+		a := avp.Attribute
+		var value FramedProtocol
+		var i uint32
+		i, err = Integer(a)
 		if err != nil {
+			err = fmt.Errorf("radius: Framed-Protocol: %w", err)
 			return
 		}
-		attrs = append(attrs, &AVP{
-			Type:      EAPMessage_Type,
-			Attribute: a,
-		})
-		value = value[n:]
+		value = FramedProtocol(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedProtocol_Lookup returns the first Framed-Protocol of p. ok is false if there is none.
+func (p *Packet) FramedProtocol_Lookup() (value FramedProtocol, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedProtocol_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Protocol: %w", err)
+		return
+	}
+	value = FramedProtocol(i)
+	return
+}
+
+// FramedProtocol_Set replaces every Framed-Protocol of p with value.
+func (p *Packet) FramedProtocol_Set(value FramedProtocol) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(FramedProtocol_Type, a)
+	return
+}
+
+// FramedProtocol_Add appends value as a Framed-Protocol to p.
+func (p *Packet) FramedProtocol_Add(value FramedProtocol) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(FramedProtocol_Type, a)
+	return
+}
+
+// FramedProtocol_Del removes every Framed-Protocol from p.
+func (p *Packet) FramedProtocol_Del() {
+	p.Attributes.Del(FramedProtocol_Type)
+}
+
+// FramedIPAddress_Get returns the first Framed-IP-Address of p, or ErrNoAttribute.
+func (p *Packet) FramedIPAddress_Get() (value net.IP, err error) {
+	value, ok, err := p.FramedIPAddress_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
 	}
-	p.Attributes = append(p.Attributes, attrs...)
 	return
 }
 
-func (p *Packet) EAPMessage_Get() (value []byte, err error) {
-	var i []byte
-	var valid bool
+// FramedIPAddress_Gets returns every Framed-IP-Address of p.
+func (p *Packet) FramedIPAddress_Gets() (values []net.IP, err error) {
 	for _, avp := range p.Attributes {
-		if avp.Type != EAPMessage_Type {
+		if avp.Type != FramedIPAddress_Type {
 			continue
 		}
-		attr := avp.Attribute
-		i = Bytes(attr)
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPAddr(a)
 		if err != nil {
+			err = fmt.Errorf("radius: Framed-IP-Address: %w", err)
 			return
 		}
-		value = append(value, i...)
-		valid = true
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPAddress_Lookup returns the first Framed-IP-Address of p. ok is false if there is none.
+func (p *Packet) FramedIPAddress_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPAddress_Type)
+	if !ok {
+		return
+	}
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IP-Address: %w", err)
+		return
+	}
+	return
+}
+
+// FramedIPAddress_Set replaces every Framed-IP-Address of p with value.
+func (p *Packet) FramedIPAddress_Set(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IP-Address: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPAddress_Type, a)
+	return
+}
+
+// FramedIPAddress_Add appends value as a Framed-IP-Address to p.
+func (p *Packet) FramedIPAddress_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IP-Address: %w", err)
+		return
 	}
-	if !valid {
+	p.Attributes.Add(FramedIPAddress_Type, a)
+	return
+}
+
+// FramedIPAddress_Del removes every Framed-IP-Address from p.
+func (p *Packet) FramedIPAddress_Del() {
+	p.Attributes.Del(FramedIPAddress_Type)
+}
+
+// FramedIPNetmask_Get returns the first Framed-IP-Netmask of p, or ErrNoAttribute.
+func (p *Packet) FramedIPNetmask_Get() (value net.IP, err error) {
+	value, ok, err := p.FramedIPNetmask_Lookup()
+	if err == nil && !ok {
 		err = ErrNoAttribute
 	}
 	return
 }
 
-func (p *Packet) State_Gets() (values [][]byte, err error) {
-	var i []byte
+// FramedIPNetmask_Gets returns every Framed-IP-Netmask of p.
+func (p *Packet) FramedIPNetmask_Gets() (values []net.IP, err error) {
 	for _, avp := range p.Attributes {
-		if avp.Type != State_Type {
+		if avp.Type != FramedIPNetmask_Type {
 			continue
 		}
-		attr := avp.Attribute
-		i = Bytes(attr)
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPAddr(a)
 		if err != nil {
+			err = fmt.Errorf("radius: Framed-IP-Netmask: %w", err)
 			return
 		}
-		values = append(values, i)
+		values = append(values, value)
 	}
 	return
 }
 
-func (p *Packet) State_Add(value []byte) (err error) {
-	a, err := NewBytes(value)
+// FramedIPNetmask_Lookup returns the first Framed-IP-Netmask of p. ok is false if there is none.
+func (p *Packet) FramedIPNetmask_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPNetmask_Type)
+	if !ok {
+		return
+	}
+	value, err = IPAddr(a)
 	if err != nil {
+		err = fmt.Errorf("radius: Framed-IP-Netmask: %w", err)
 		return
 	}
-	p.Add(State_Type, a)
 	return
 }
 
-func (p *Packet) MessageAuthenticator_Set(secret string) (err error) {
+// FramedIPNetmask_Set replaces every Framed-IP-Netmask of p with value.
+func (p *Packet) FramedIPNetmask_Set(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IP-Netmask: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPNetmask_Type, a)
+	return
+}
 
-	msgAuth := make([]byte, 16)
-	a, err := NewBytes(msgAuth)
+// FramedIPNetmask_Add appends value as a Framed-IP-Netmask to p.
+func (p *Packet) FramedIPNetmask_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
 	if err != nil {
-		return err
+		err = fmt.Errorf("radius: Framed-IP-Netmask: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPNetmask_Type, a)
+	return
+}
+
+// FramedIPNetmask_Del removes every Framed-IP-Netmask from p.
+func (p *Packet) FramedIPNetmask_Del() {
+	p.Attributes.Del(FramedIPNetmask_Type)
+}
+
+type FramedRouting uint32
+
+const (
+	FramedRouting_Value_None            FramedRouting = 0
+	FramedRouting_Value_Broadcast       FramedRouting = 1
+	FramedRouting_Value_Listen          FramedRouting = 2
+	FramedRouting_Value_BroadcastListen FramedRouting = 3
+)
+
+var FramedRouting_Strings = map[FramedRouting]string{
+	FramedRouting_Value_None:            "None",
+	FramedRouting_Value_Broadcast:       "Broadcast",
+	FramedRouting_Value_Listen:          "Listen",
+	FramedRouting_Value_BroadcastListen: "Broadcast-Listen",
+}
+
+func (a FramedRouting) String() string {
+	if str, ok := FramedRouting_Strings[a]; ok {
+		return str
+	}
+	return "FramedRouting(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// FramedRouting_Get returns the first Framed-Routing of p, or ErrNoAttribute.
+func (p *Packet) FramedRouting_Get() (value FramedRouting, err error) {
+	value, ok, err := p.FramedRouting_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedRouting_Gets returns every Framed-Routing of p.
+func (p *Packet) FramedRouting_Gets() (values []FramedRouting, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedRouting_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value FramedRouting
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-Routing: %w", err)
+			return
+		}
+		value = FramedRouting(i)
+		values = append(values, value)
 	}
-	p.Set(MessageAuthenticator_Type, a)
+	return
+}
 
-	chunk, err := p.MarshalBinary()
+// FramedRouting_Lookup returns the first Framed-Routing of p. ok is false if there is none.
+func (p *Packet) FramedRouting_Lookup() (value FramedRouting, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedRouting_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
 	if err != nil {
-		return err
+		err = fmt.Errorf("radius: Framed-Routing: %w", err)
+		return
+	}
+	value = FramedRouting(i)
+	return
+}
+
+// FramedRouting_Set replaces every Framed-Routing of p with value.
+func (p *Packet) FramedRouting_Set(value FramedRouting) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(FramedRouting_Type, a)
+	return
+}
+
+// FramedRouting_Add appends value as a Framed-Routing to p.
+func (p *Packet) FramedRouting_Add(value FramedRouting) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(FramedRouting_Type, a)
+	return
+}
+
+// FramedRouting_Del removes every Framed-Routing from p.
+func (p *Packet) FramedRouting_Del() {
+	p.Attributes.Del(FramedRouting_Type)
+}
+
+// FilterID_Get returns the first Filter-Id of p, or ErrNoAttribute.
+func (p *Packet) FilterID_Get() (value string, err error) {
+	value, ok, err := p.FilterID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FilterID_Gets returns every Filter-Id of p.
+func (p *Packet) FilterID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FilterID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FilterID_Lookup returns the first Filter-Id of p. ok is false if there is none.
+func (p *Packet) FilterID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FilterID_Type)
+	if !ok {
+		return
 	}
+	value = String(a)
+	return
+}
 
-	mac := hmac.New(md5.New, []byte(secret))
-	mac.Write(chunk)
-	result := mac.Sum(nil)
-	if len(result) != 16 {
-		return errors.New("hmac failed")
+// FilterID_Set replaces every Filter-Id of p with value.
+func (p *Packet) FilterID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Filter-Id: %w", err)
+		return
 	}
+	p.Attributes.Set(FilterID_Type, a)
+	return
+}
 
-	a, err = NewBytes(result)
+// FilterID_Add appends value as a Filter-Id to p.
+func (p *Packet) FilterID_Add(value string) (err error) {
+	a, err := NewString(value)
 	if err != nil {
+		err = fmt.Errorf("radius: Filter-Id: %w", err)
 		return
 	}
-	p.Set(MessageAuthenticator_Type, a)
+	p.Attributes.Add(FilterID_Type, a)
 	return
 }
+
+// FilterID_Del removes every Filter-Id from p.
+func (p *Packet) FilterID_Del() {
+	p.Attributes.Del(FilterID_Type)
+}
+
+// FramedMTU_Get returns the first Framed-MTU of p, or ErrNoAttribute.
+func (p *Packet) FramedMTU_Get() (value uint32, err error) {
+	value, ok, err := p.FramedMTU_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedMTU_Gets returns every Framed-MTU of p.
+func (p *Packet) FramedMTU_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedMTU_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-MTU: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedMTU_Lookup returns the first Framed-MTU of p. ok is false if there is none.
+func (p *Packet) FramedMTU_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedMTU_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-MTU: %w", err)
+		return
+	}
+	return
+}
+
+// FramedMTU_Set replaces every Framed-MTU of p with value.
+func (p *Packet) FramedMTU_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(FramedMTU_Type, a)
+	return
+}
+
+// FramedMTU_Add appends value as a Framed-MTU to p.
+func (p *Packet) FramedMTU_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(FramedMTU_Type, a)
+	return
+}
+
+// FramedMTU_Del removes every Framed-MTU from p.
+func (p *Packet) FramedMTU_Del() {
+	p.Attributes.Del(FramedMTU_Type)
+}
+
+type FramedCompression uint32
+
+const (
+	FramedCompression_Value_None                 FramedCompression = 0
+	FramedCompression_Value_VanJacobsonTCPIP     FramedCompression = 1
+	FramedCompression_Value_IPXHeaderCompression FramedCompression = 2
+	FramedCompression_Value_StacLZS              FramedCompression = 3
+)
+
+var FramedCompression_Strings = map[FramedCompression]string{
+	FramedCompression_Value_None:                 "None",
+	FramedCompression_Value_VanJacobsonTCPIP:     "Van-Jacobson-TCP-IP",
+	FramedCompression_Value_IPXHeaderCompression: "IPX-Header-Compression",
+	FramedCompression_Value_StacLZS:              "Stac-LZS",
+}
+
+func (a FramedCompression) String() string {
+	if str, ok := FramedCompression_Strings[a]; ok {
+		return str
+	}
+	return "FramedCompression(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// FramedCompression_Get returns the first Framed-Compression of p, or ErrNoAttribute.
+func (p *Packet) FramedCompression_Get() (value FramedCompression, err error) {
+	value, ok, err := p.FramedCompression_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedCompression_Gets returns every Framed-Compression of p.
+func (p *Packet) FramedCompression_Gets() (values []FramedCompression, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedCompression_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value FramedCompression
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-Compression: %w", err)
+			return
+		}
+		value = FramedCompression(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedCompression_Lookup returns the first Framed-Compression of p. ok is false if there is none.
+func (p *Packet) FramedCompression_Lookup() (value FramedCompression, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedCompression_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Compression: %w", err)
+		return
+	}
+	value = FramedCompression(i)
+	return
+}
+
+// FramedCompression_Set replaces every Framed-Compression of p with value.
+func (p *Packet) FramedCompression_Set(value FramedCompression) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(FramedCompression_Type, a)
+	return
+}
+
+// FramedCompression_Add appends value as a Framed-Compression to p.
+func (p *Packet) FramedCompression_Add(value FramedCompression) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(FramedCompression_Type, a)
+	return
+}
+
+// FramedCompression_Del removes every Framed-Compression from p.
+func (p *Packet) FramedCompression_Del() {
+	p.Attributes.Del(FramedCompression_Type)
+}
+
+// LoginIPHost_Get returns the first Login-IP-Host of p, or ErrNoAttribute.
+func (p *Packet) LoginIPHost_Get() (value net.IP, err error) {
+	value, ok, err := p.LoginIPHost_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginIPHost_Gets returns every Login-IP-Host of p.
+func (p *Packet) LoginIPHost_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginIPHost_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Login-IP-Host: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginIPHost_Lookup returns the first Login-IP-Host of p. ok is false if there is none.
+func (p *Packet) LoginIPHost_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginIPHost_Type)
+	if !ok {
+		return
+	}
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IP-Host: %w", err)
+		return
+	}
+	return
+}
+
+// LoginIPHost_Set replaces every Login-IP-Host of p with value.
+func (p *Packet) LoginIPHost_Set(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IP-Host: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginIPHost_Type, a)
+	return
+}
+
+// LoginIPHost_Add appends value as a Login-IP-Host to p.
+func (p *Packet) LoginIPHost_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IP-Host: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginIPHost_Type, a)
+	return
+}
+
+// LoginIPHost_Del removes every Login-IP-Host from p.
+func (p *Packet) LoginIPHost_Del() {
+	p.Attributes.Del(LoginIPHost_Type)
+}
+
+type LoginService uint32
+
+const (
+	LoginService_Value_Telnet        LoginService = 0
+	LoginService_Value_Rlogin        LoginService = 1
+	LoginService_Value_TCPClear      LoginService = 2
+	LoginService_Value_PortMaster    LoginService = 3
+	LoginService_Value_LAT           LoginService = 4
+	LoginService_Value_X25PAD        LoginService = 5
+	LoginService_Value_X25T3POS      LoginService = 6
+	LoginService_Value_TCPClearQuiet LoginService = 8
+)
+
+var LoginService_Strings = map[LoginService]string{
+	LoginService_Value_Telnet:        "Telnet",
+	LoginService_Value_Rlogin:        "Rlogin",
+	LoginService_Value_TCPClear:      "TCP-Clear",
+	LoginService_Value_PortMaster:    "PortMaster",
+	LoginService_Value_LAT:           "LAT",
+	LoginService_Value_X25PAD:        "X25-PAD",
+	LoginService_Value_X25T3POS:      "X25-T3POS",
+	LoginService_Value_TCPClearQuiet: "TCP-Clear-Quiet",
+}
+
+func (a LoginService) String() string {
+	if str, ok := LoginService_Strings[a]; ok {
+		return str
+	}
+	return "LoginService(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// LoginService_Get returns the first Login-Service of p, or ErrNoAttribute.
+func (p *Packet) LoginService_Get() (value LoginService, err error) {
+	value, ok, err := p.LoginService_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginService_Gets returns every Login-Service of p.
+func (p *Packet) LoginService_Gets() (values []LoginService, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginService_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value LoginService
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Login-Service: %w", err)
+			return
+		}
+		value = LoginService(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginService_Lookup returns the first Login-Service of p. ok is false if there is none.
+func (p *Packet) LoginService_Lookup() (value LoginService, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginService_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-Service: %w", err)
+		return
+	}
+	value = LoginService(i)
+	return
+}
+
+// LoginService_Set replaces every Login-Service of p with value.
+func (p *Packet) LoginService_Set(value LoginService) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(LoginService_Type, a)
+	return
+}
+
+// LoginService_Add appends value as a Login-Service to p.
+func (p *Packet) LoginService_Add(value LoginService) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(LoginService_Type, a)
+	return
+}
+
+// LoginService_Del removes every Login-Service from p.
+func (p *Packet) LoginService_Del() {
+	p.Attributes.Del(LoginService_Type)
+}
+
+type LoginTCPPort uint32
+
+const (
+	LoginTCPPort_Value_Telnet LoginTCPPort = 23
+	LoginTCPPort_Value_Rlogin LoginTCPPort = 513
+	LoginTCPPort_Value_Rsh    LoginTCPPort = 514
+)
+
+var LoginTCPPort_Strings = map[LoginTCPPort]string{
+	LoginTCPPort_Value_Telnet: "Telnet",
+	LoginTCPPort_Value_Rlogin: "Rlogin",
+	LoginTCPPort_Value_Rsh:    "Rsh",
+}
+
+func (a LoginTCPPort) String() string {
+	if str, ok := LoginTCPPort_Strings[a]; ok {
+		return str
+	}
+	return "LoginTCPPort(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// LoginTCPPort_Get returns the first Login-TCP-Port of p, or ErrNoAttribute.
+func (p *Packet) LoginTCPPort_Get() (value LoginTCPPort, err error) {
+	value, ok, err := p.LoginTCPPort_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginTCPPort_Gets returns every Login-TCP-Port of p.
+func (p *Packet) LoginTCPPort_Gets() (values []LoginTCPPort, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginTCPPort_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value LoginTCPPort
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Login-TCP-Port: %w", err)
+			return
+		}
+		value = LoginTCPPort(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginTCPPort_Lookup returns the first Login-TCP-Port of p. ok is false if there is none.
+func (p *Packet) LoginTCPPort_Lookup() (value LoginTCPPort, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginTCPPort_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-TCP-Port: %w", err)
+		return
+	}
+	value = LoginTCPPort(i)
+	return
+}
+
+// LoginTCPPort_Set replaces every Login-TCP-Port of p with value.
+func (p *Packet) LoginTCPPort_Set(value LoginTCPPort) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(LoginTCPPort_Type, a)
+	return
+}
+
+// LoginTCPPort_Add appends value as a Login-TCP-Port to p.
+func (p *Packet) LoginTCPPort_Add(value LoginTCPPort) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(LoginTCPPort_Type, a)
+	return
+}
+
+// LoginTCPPort_Del removes every Login-TCP-Port from p.
+func (p *Packet) LoginTCPPort_Del() {
+	p.Attributes.Del(LoginTCPPort_Type)
+}
+
+// ReplyMessage_Get returns the first Reply-Message of p, or ErrNoAttribute.
+func (p *Packet) ReplyMessage_Get() (value string, err error) {
+	value, ok, err := p.ReplyMessage_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ReplyMessage_Gets returns every Reply-Message of p.
+func (p *Packet) ReplyMessage_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ReplyMessage_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ReplyMessage_Lookup returns the first Reply-Message of p. ok is false if there is none.
+func (p *Packet) ReplyMessage_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ReplyMessage_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// ReplyMessage_Set replaces every Reply-Message of p with value.
+func (p *Packet) ReplyMessage_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Reply-Message: %w", err)
+		return
+	}
+	p.Attributes.Set(ReplyMessage_Type, a)
+	return
+}
+
+// ReplyMessage_Add appends value as a Reply-Message to p.
+func (p *Packet) ReplyMessage_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Reply-Message: %w", err)
+		return
+	}
+	p.Attributes.Add(ReplyMessage_Type, a)
+	return
+}
+
+// ReplyMessage_Del removes every Reply-Message from p.
+func (p *Packet) ReplyMessage_Del() {
+	p.Attributes.Del(ReplyMessage_Type)
+}
+
+// CallbackNumber_Get returns the first Callback-Number of p, or ErrNoAttribute.
+func (p *Packet) CallbackNumber_Get() (value string, err error) {
+	value, ok, err := p.CallbackNumber_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CallbackNumber_Gets returns every Callback-Number of p.
+func (p *Packet) CallbackNumber_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != CallbackNumber_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CallbackNumber_Lookup returns the first Callback-Number of p. ok is false if there is none.
+func (p *Packet) CallbackNumber_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(CallbackNumber_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// CallbackNumber_Set replaces every Callback-Number of p with value.
+func (p *Packet) CallbackNumber_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Callback-Number: %w", err)
+		return
+	}
+	p.Attributes.Set(CallbackNumber_Type, a)
+	return
+}
+
+// CallbackNumber_Add appends value as a Callback-Number to p.
+func (p *Packet) CallbackNumber_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Callback-Number: %w", err)
+		return
+	}
+	p.Attributes.Add(CallbackNumber_Type, a)
+	return
+}
+
+// CallbackNumber_Del removes every Callback-Number from p.
+func (p *Packet) CallbackNumber_Del() {
+	p.Attributes.Del(CallbackNumber_Type)
+}
+
+// CallbackID_Get returns the first Callback-Id of p, or ErrNoAttribute.
+func (p *Packet) CallbackID_Get() (value string, err error) {
+	value, ok, err := p.CallbackID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CallbackID_Gets returns every Callback-Id of p.
+func (p *Packet) CallbackID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != CallbackID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CallbackID_Lookup returns the first Callback-Id of p. ok is false if there is none.
+func (p *Packet) CallbackID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(CallbackID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// CallbackID_Set replaces every Callback-Id of p with value.
+func (p *Packet) CallbackID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Callback-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(CallbackID_Type, a)
+	return
+}
+
+// CallbackID_Add appends value as a Callback-Id to p.
+func (p *Packet) CallbackID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Callback-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(CallbackID_Type, a)
+	return
+}
+
+// CallbackID_Del removes every Callback-Id from p.
+func (p *Packet) CallbackID_Del() {
+	p.Attributes.Del(CallbackID_Type)
+}
+
+// FramedRoute_Get returns the first Framed-Route of p, or ErrNoAttribute.
+func (p *Packet) FramedRoute_Get() (value string, err error) {
+	value, ok, err := p.FramedRoute_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedRoute_Gets returns every Framed-Route of p.
+func (p *Packet) FramedRoute_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedRoute_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedRoute_Lookup returns the first Framed-Route of p. ok is false if there is none.
+func (p *Packet) FramedRoute_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedRoute_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// FramedRoute_Set replaces every Framed-Route of p with value.
+func (p *Packet) FramedRoute_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Route: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedRoute_Type, a)
+	return
+}
+
+// FramedRoute_Add appends value as a Framed-Route to p.
+func (p *Packet) FramedRoute_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Route: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedRoute_Type, a)
+	return
+}
+
+// FramedRoute_Del removes every Framed-Route from p.
+func (p *Packet) FramedRoute_Del() {
+	p.Attributes.Del(FramedRoute_Type)
+}
+
+// FramedIPXNetwork_Get returns the first Framed-IPX-Network of p, or ErrNoAttribute.
+func (p *Packet) FramedIPXNetwork_Get() (value net.IP, err error) {
+	value, ok, err := p.FramedIPXNetwork_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedIPXNetwork_Gets returns every Framed-IPX-Network of p.
+func (p *Packet) FramedIPXNetwork_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedIPXNetwork_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-IPX-Network: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPXNetwork_Lookup returns the first Framed-IPX-Network of p. ok is false if there is none.
+func (p *Packet) FramedIPXNetwork_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPXNetwork_Type)
+	if !ok {
+		return
+	}
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPX-Network: %w", err)
+		return
+	}
+	return
+}
+
+// FramedIPXNetwork_Set replaces every Framed-IPX-Network of p with value.
+func (p *Packet) FramedIPXNetwork_Set(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPX-Network: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPXNetwork_Type, a)
+	return
+}
+
+// FramedIPXNetwork_Add appends value as a Framed-IPX-Network to p.
+func (p *Packet) FramedIPXNetwork_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPX-Network: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPXNetwork_Type, a)
+	return
+}
+
+// FramedIPXNetwork_Del removes every Framed-IPX-Network from p.
+func (p *Packet) FramedIPXNetwork_Del() {
+	p.Attributes.Del(FramedIPXNetwork_Type)
+}
+
+// State_Get returns the first State of p, or ErrNoAttribute.
+func (p *Packet) State_Get() (value []byte, err error) {
+	value, ok, err := p.State_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// State_Gets returns every State of p.
+func (p *Packet) State_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != State_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// State_Lookup returns the first State of p. ok is false if there is none.
+func (p *Packet) State_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(State_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// State_Set replaces every State of p with value.
+func (p *Packet) State_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: State: %w", err)
+		return
+	}
+	p.Attributes.Set(State_Type, a)
+	return
+}
+
+// State_Add appends value as a State to p.
+func (p *Packet) State_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: State: %w", err)
+		return
+	}
+	p.Attributes.Add(State_Type, a)
+	return
+}
+
+// State_Del removes every State from p.
+func (p *Packet) State_Del() {
+	p.Attributes.Del(State_Type)
+}
+
+// Class_Get returns the first Class of p, or ErrNoAttribute.
+func (p *Packet) Class_Get() (value []byte, err error) {
+	value, ok, err := p.Class_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// Class_Gets returns every Class of p.
+func (p *Packet) Class_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != Class_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// Class_Lookup returns the first Class of p. ok is false if there is none.
+func (p *Packet) Class_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(Class_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// Class_Set replaces every Class of p with value.
+func (p *Packet) Class_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Class: %w", err)
+		return
+	}
+	p.Attributes.Set(Class_Type, a)
+	return
+}
+
+// Class_Add appends value as a Class to p.
+func (p *Packet) Class_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Class: %w", err)
+		return
+	}
+	p.Attributes.Add(Class_Type, a)
+	return
+}
+
+// Class_Del removes every Class from p.
+func (p *Packet) Class_Del() {
+	p.Attributes.Del(Class_Type)
+}
+
+// SessionTimeout_Get returns the first Session-Timeout of p, or ErrNoAttribute.
+func (p *Packet) SessionTimeout_Get() (value uint32, err error) {
+	value, ok, err := p.SessionTimeout_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// SessionTimeout_Gets returns every Session-Timeout of p.
+func (p *Packet) SessionTimeout_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != SessionTimeout_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Session-Timeout: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// SessionTimeout_Lookup returns the first Session-Timeout of p. ok is false if there is none.
+func (p *Packet) SessionTimeout_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(SessionTimeout_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Session-Timeout: %w", err)
+		return
+	}
+	return
+}
+
+// SessionTimeout_Set replaces every Session-Timeout of p with value.
+func (p *Packet) SessionTimeout_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(SessionTimeout_Type, a)
+	return
+}
+
+// SessionTimeout_Add appends value as a Session-Timeout to p.
+func (p *Packet) SessionTimeout_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(SessionTimeout_Type, a)
+	return
+}
+
+// SessionTimeout_Del removes every Session-Timeout from p.
+func (p *Packet) SessionTimeout_Del() {
+	p.Attributes.Del(SessionTimeout_Type)
+}
+
+// IdleTimeout_Get returns the first Idle-Timeout of p, or ErrNoAttribute.
+func (p *Packet) IdleTimeout_Get() (value uint32, err error) {
+	value, ok, err := p.IdleTimeout_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// IdleTimeout_Gets returns every Idle-Timeout of p.
+func (p *Packet) IdleTimeout_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != IdleTimeout_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Idle-Timeout: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// IdleTimeout_Lookup returns the first Idle-Timeout of p. ok is false if there is none.
+func (p *Packet) IdleTimeout_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(IdleTimeout_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Idle-Timeout: %w", err)
+		return
+	}
+	return
+}
+
+// IdleTimeout_Set replaces every Idle-Timeout of p with value.
+func (p *Packet) IdleTimeout_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(IdleTimeout_Type, a)
+	return
+}
+
+// IdleTimeout_Add appends value as a Idle-Timeout to p.
+func (p *Packet) IdleTimeout_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(IdleTimeout_Type, a)
+	return
+}
+
+// IdleTimeout_Del removes every Idle-Timeout from p.
+func (p *Packet) IdleTimeout_Del() {
+	p.Attributes.Del(IdleTimeout_Type)
+}
+
+type TerminationAction uint32
+
+const (
+	TerminationAction_Value_Default       TerminationAction = 0
+	TerminationAction_Value_RADIUSRequest TerminationAction = 1
+)
+
+var TerminationAction_Strings = map[TerminationAction]string{
+	TerminationAction_Value_Default:       "Default",
+	TerminationAction_Value_RADIUSRequest: "RADIUS-Request",
+}
+
+func (a TerminationAction) String() string {
+	if str, ok := TerminationAction_Strings[a]; ok {
+		return str
+	}
+	return "TerminationAction(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// TerminationAction_Get returns the first Termination-Action of p, or ErrNoAttribute.
+func (p *Packet) TerminationAction_Get() (value TerminationAction, err error) {
+	value, ok, err := p.TerminationAction_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TerminationAction_Gets returns every Termination-Action of p.
+func (p *Packet) TerminationAction_Gets() (values []TerminationAction, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TerminationAction_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value TerminationAction
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Termination-Action: %w", err)
+			return
+		}
+		value = TerminationAction(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// TerminationAction_Lookup returns the first Termination-Action of p. ok is false if there is none.
+func (p *Packet) TerminationAction_Lookup() (value TerminationAction, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(TerminationAction_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Termination-Action: %w", err)
+		return
+	}
+	value = TerminationAction(i)
+	return
+}
+
+// TerminationAction_Set replaces every Termination-Action of p with value.
+func (p *Packet) TerminationAction_Set(value TerminationAction) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(TerminationAction_Type, a)
+	return
+}
+
+// TerminationAction_Add appends value as a Termination-Action to p.
+func (p *Packet) TerminationAction_Add(value TerminationAction) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(TerminationAction_Type, a)
+	return
+}
+
+// TerminationAction_Del removes every Termination-Action from p.
+func (p *Packet) TerminationAction_Del() {
+	p.Attributes.Del(TerminationAction_Type)
+}
+
+// CalledStationID_Get returns the first Called-Station-Id of p, or ErrNoAttribute.
+func (p *Packet) CalledStationID_Get() (value string, err error) {
+	value, ok, err := p.CalledStationID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CalledStationID_Gets returns every Called-Station-Id of p.
+func (p *Packet) CalledStationID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != CalledStationID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CalledStationID_Lookup returns the first Called-Station-Id of p. ok is false if there is none.
+func (p *Packet) CalledStationID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(CalledStationID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// CalledStationID_Set replaces every Called-Station-Id of p with value.
+func (p *Packet) CalledStationID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Called-Station-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(CalledStationID_Type, a)
+	return
+}
+
+// CalledStationID_Add appends value as a Called-Station-Id to p.
+func (p *Packet) CalledStationID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Called-Station-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(CalledStationID_Type, a)
+	return
+}
+
+// CalledStationID_Del removes every Called-Station-Id from p.
+func (p *Packet) CalledStationID_Del() {
+	p.Attributes.Del(CalledStationID_Type)
+}
+
+// CallingStationID_Get returns the first Calling-Station-Id of p, or ErrNoAttribute.
+func (p *Packet) CallingStationID_Get() (value string, err error) {
+	value, ok, err := p.CallingStationID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CallingStationID_Gets returns every Calling-Station-Id of p.
+func (p *Packet) CallingStationID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != CallingStationID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CallingStationID_Lookup returns the first Calling-Station-Id of p. ok is false if there is none.
+func (p *Packet) CallingStationID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(CallingStationID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// CallingStationID_Set replaces every Calling-Station-Id of p with value.
+func (p *Packet) CallingStationID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Calling-Station-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(CallingStationID_Type, a)
+	return
+}
+
+// CallingStationID_Add appends value as a Calling-Station-Id to p.
+func (p *Packet) CallingStationID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Calling-Station-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(CallingStationID_Type, a)
+	return
+}
+
+// CallingStationID_Del removes every Calling-Station-Id from p.
+func (p *Packet) CallingStationID_Del() {
+	p.Attributes.Del(CallingStationID_Type)
+}
+
+// NASIdentifier_Get returns the first NAS-Identifier of p, or ErrNoAttribute.
+func (p *Packet) NASIdentifier_Get() (value string, err error) {
+	value, ok, err := p.NASIdentifier_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// NASIdentifier_Gets returns every NAS-Identifier of p.
+func (p *Packet) NASIdentifier_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASIdentifier_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// NASIdentifier_Lookup returns the first NAS-Identifier of p. ok is false if there is none.
+func (p *Packet) NASIdentifier_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASIdentifier_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// NASIdentifier_Set replaces every NAS-Identifier of p with value.
+func (p *Packet) NASIdentifier_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-Identifier: %w", err)
+		return
+	}
+	p.Attributes.Set(NASIdentifier_Type, a)
+	return
+}
+
+// NASIdentifier_Add appends value as a NAS-Identifier to p.
+func (p *Packet) NASIdentifier_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-Identifier: %w", err)
+		return
+	}
+	p.Attributes.Add(NASIdentifier_Type, a)
+	return
+}
+
+// NASIdentifier_Del removes every NAS-Identifier from p.
+func (p *Packet) NASIdentifier_Del() {
+	p.Attributes.Del(NASIdentifier_Type)
+}
+
+// ProxyState_Get returns the first Proxy-State of p, or ErrNoAttribute.
+func (p *Packet) ProxyState_Get() (value []byte, err error) {
+	value, ok, err := p.ProxyState_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ProxyState_Gets returns every Proxy-State of p.
+func (p *Packet) ProxyState_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ProxyState_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ProxyState_Lookup returns the first Proxy-State of p. ok is false if there is none.
+func (p *Packet) ProxyState_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ProxyState_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// ProxyState_Set replaces every Proxy-State of p with value.
+func (p *Packet) ProxyState_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Proxy-State: %w", err)
+		return
+	}
+	p.Attributes.Set(ProxyState_Type, a)
+	return
+}
+
+// ProxyState_Add appends value as a Proxy-State to p.
+func (p *Packet) ProxyState_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Proxy-State: %w", err)
+		return
+	}
+	p.Attributes.Add(ProxyState_Type, a)
+	return
+}
+
+// ProxyState_Del removes every Proxy-State from p.
+func (p *Packet) ProxyState_Del() {
+	p.Attributes.Del(ProxyState_Type)
+}
+
+// LoginLATService_Get returns the first Login-LAT-Service of p, or ErrNoAttribute.
+func (p *Packet) LoginLATService_Get() (value string, err error) {
+	value, ok, err := p.LoginLATService_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginLATService_Gets returns every Login-LAT-Service of p.
+func (p *Packet) LoginLATService_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginLATService_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginLATService_Lookup returns the first Login-LAT-Service of p. ok is false if there is none.
+func (p *Packet) LoginLATService_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginLATService_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// LoginLATService_Set replaces every Login-LAT-Service of p with value.
+func (p *Packet) LoginLATService_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Service: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginLATService_Type, a)
+	return
+}
+
+// LoginLATService_Add appends value as a Login-LAT-Service to p.
+func (p *Packet) LoginLATService_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Service: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginLATService_Type, a)
+	return
+}
+
+// LoginLATService_Del removes every Login-LAT-Service from p.
+func (p *Packet) LoginLATService_Del() {
+	p.Attributes.Del(LoginLATService_Type)
+}
+
+// LoginLATNode_Get returns the first Login-LAT-Node of p, or ErrNoAttribute.
+func (p *Packet) LoginLATNode_Get() (value string, err error) {
+	value, ok, err := p.LoginLATNode_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginLATNode_Gets returns every Login-LAT-Node of p.
+func (p *Packet) LoginLATNode_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginLATNode_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginLATNode_Lookup returns the first Login-LAT-Node of p. ok is false if there is none.
+func (p *Packet) LoginLATNode_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginLATNode_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// LoginLATNode_Set replaces every Login-LAT-Node of p with value.
+func (p *Packet) LoginLATNode_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Node: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginLATNode_Type, a)
+	return
+}
+
+// LoginLATNode_Add appends value as a Login-LAT-Node to p.
+func (p *Packet) LoginLATNode_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Node: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginLATNode_Type, a)
+	return
+}
+
+// LoginLATNode_Del removes every Login-LAT-Node from p.
+func (p *Packet) LoginLATNode_Del() {
+	p.Attributes.Del(LoginLATNode_Type)
+}
+
+// LoginLATGroup_Get returns the first Login-LAT-Group of p, or ErrNoAttribute.
+func (p *Packet) LoginLATGroup_Get() (value []byte, err error) {
+	value, ok, err := p.LoginLATGroup_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginLATGroup_Gets returns every Login-LAT-Group of p.
+func (p *Packet) LoginLATGroup_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginLATGroup_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginLATGroup_Lookup returns the first Login-LAT-Group of p. ok is false if there is none.
+func (p *Packet) LoginLATGroup_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginLATGroup_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// LoginLATGroup_Set replaces every Login-LAT-Group of p with value.
+func (p *Packet) LoginLATGroup_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Group: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginLATGroup_Type, a)
+	return
+}
+
+// LoginLATGroup_Add appends value as a Login-LAT-Group to p.
+func (p *Packet) LoginLATGroup_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Group: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginLATGroup_Type, a)
+	return
+}
+
+// LoginLATGroup_Del removes every Login-LAT-Group from p.
+func (p *Packet) LoginLATGroup_Del() {
+	p.Attributes.Del(LoginLATGroup_Type)
+}
+
+// FramedAppleTalkLink_Get returns the first Framed-AppleTalk-Link of p, or ErrNoAttribute.
+func (p *Packet) FramedAppleTalkLink_Get() (value uint32, err error) {
+	value, ok, err := p.FramedAppleTalkLink_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedAppleTalkLink_Gets returns every Framed-AppleTalk-Link of p.
+func (p *Packet) FramedAppleTalkLink_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedAppleTalkLink_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-AppleTalk-Link: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedAppleTalkLink_Lookup returns the first Framed-AppleTalk-Link of p. ok is false if there is none.
+func (p *Packet) FramedAppleTalkLink_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedAppleTalkLink_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-AppleTalk-Link: %w", err)
+		return
+	}
+	return
+}
+
+// FramedAppleTalkLink_Set replaces every Framed-AppleTalk-Link of p with value.
+func (p *Packet) FramedAppleTalkLink_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(FramedAppleTalkLink_Type, a)
+	return
+}
+
+// FramedAppleTalkLink_Add appends value as a Framed-AppleTalk-Link to p.
+func (p *Packet) FramedAppleTalkLink_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(FramedAppleTalkLink_Type, a)
+	return
+}
+
+// FramedAppleTalkLink_Del removes every Framed-AppleTalk-Link from p.
+func (p *Packet) FramedAppleTalkLink_Del() {
+	p.Attributes.Del(FramedAppleTalkLink_Type)
+}
+
+// FramedAppleTalkNetwork_Get returns the first Framed-AppleTalk-Network of p, or ErrNoAttribute.
+func (p *Packet) FramedAppleTalkNetwork_Get() (value uint32, err error) {
+	value, ok, err := p.FramedAppleTalkNetwork_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedAppleTalkNetwork_Gets returns every Framed-AppleTalk-Network of p.
+func (p *Packet) FramedAppleTalkNetwork_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedAppleTalkNetwork_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-AppleTalk-Network: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedAppleTalkNetwork_Lookup returns the first Framed-AppleTalk-Network of p. ok is false if there is none.
+func (p *Packet) FramedAppleTalkNetwork_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedAppleTalkNetwork_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-AppleTalk-Network: %w", err)
+		return
+	}
+	return
+}
+
+// FramedAppleTalkNetwork_Set replaces every Framed-AppleTalk-Network of p with value.
+func (p *Packet) FramedAppleTalkNetwork_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(FramedAppleTalkNetwork_Type, a)
+	return
+}
+
+// FramedAppleTalkNetwork_Add appends value as a Framed-AppleTalk-Network to p.
+func (p *Packet) FramedAppleTalkNetwork_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(FramedAppleTalkNetwork_Type, a)
+	return
+}
+
+// FramedAppleTalkNetwork_Del removes every Framed-AppleTalk-Network from p.
+func (p *Packet) FramedAppleTalkNetwork_Del() {
+	p.Attributes.Del(FramedAppleTalkNetwork_Type)
+}
+
+// FramedAppleTalkZone_Get returns the first Framed-AppleTalk-Zone of p, or ErrNoAttribute.
+func (p *Packet) FramedAppleTalkZone_Get() (value string, err error) {
+	value, ok, err := p.FramedAppleTalkZone_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedAppleTalkZone_Gets returns every Framed-AppleTalk-Zone of p.
+func (p *Packet) FramedAppleTalkZone_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedAppleTalkZone_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedAppleTalkZone_Lookup returns the first Framed-AppleTalk-Zone of p. ok is false if there is none.
+func (p *Packet) FramedAppleTalkZone_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedAppleTalkZone_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// FramedAppleTalkZone_Set replaces every Framed-AppleTalk-Zone of p with value.
+func (p *Packet) FramedAppleTalkZone_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-AppleTalk-Zone: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedAppleTalkZone_Type, a)
+	return
+}
+
+// FramedAppleTalkZone_Add appends value as a Framed-AppleTalk-Zone to p.
+func (p *Packet) FramedAppleTalkZone_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-AppleTalk-Zone: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedAppleTalkZone_Type, a)
+	return
+}
+
+// FramedAppleTalkZone_Del removes every Framed-AppleTalk-Zone from p.
+func (p *Packet) FramedAppleTalkZone_Del() {
+	p.Attributes.Del(FramedAppleTalkZone_Type)
+}
+
+// CHAPChallenge_Get returns the first CHAP-Challenge of p, or ErrNoAttribute.
+func (p *Packet) CHAPChallenge_Get() (value []byte, err error) {
+	value, ok, err := p.CHAPChallenge_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CHAPChallenge_Gets returns every CHAP-Challenge of p.
+func (p *Packet) CHAPChallenge_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != CHAPChallenge_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CHAPChallenge_Lookup returns the first CHAP-Challenge of p. ok is false if there is none.
+func (p *Packet) CHAPChallenge_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(CHAPChallenge_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// CHAPChallenge_Set replaces every CHAP-Challenge of p with value.
+func (p *Packet) CHAPChallenge_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: CHAP-Challenge: %w", err)
+		return
+	}
+	p.Attributes.Set(CHAPChallenge_Type, a)
+	return
+}
+
+// CHAPChallenge_Add appends value as a CHAP-Challenge to p.
+func (p *Packet) CHAPChallenge_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: CHAP-Challenge: %w", err)
+		return
+	}
+	p.Attributes.Add(CHAPChallenge_Type, a)
+	return
+}
+
+// CHAPChallenge_Del removes every CHAP-Challenge from p.
+func (p *Packet) CHAPChallenge_Del() {
+	p.Attributes.Del(CHAPChallenge_Type)
+}
+
+type NASPortType uint32
+
+const (
+	NASPortType_Value_Async            NASPortType = 0
+	NASPortType_Value_Sync             NASPortType = 1
+	NASPortType_Value_ISDN             NASPortType = 2
+	NASPortType_Value_ISDNV120         NASPortType = 3
+	NASPortType_Value_ISDNV110         NASPortType = 4
+	NASPortType_Value_Virtual          NASPortType = 5
+	NASPortType_Value_PIAFS            NASPortType = 6
+	NASPortType_Value_HDLCClearChannel NASPortType = 7
+	NASPortType_Value_X25              NASPortType = 8
+	NASPortType_Value_X75              NASPortType = 9
+	NASPortType_Value_G3Fax            NASPortType = 10
+	NASPortType_Value_SDSL             NASPortType = 11
+	NASPortType_Value_ADSLCAP          NASPortType = 12
+	NASPortType_Value_ADSLDMT          NASPortType = 13
+	NASPortType_Value_IDSL             NASPortType = 14
+	NASPortType_Value_Ethernet         NASPortType = 15
+	NASPortType_Value_XDSL             NASPortType = 16
+	NASPortType_Value_Cable            NASPortType = 17
+	NASPortType_Value_WirelessOther    NASPortType = 18
+	NASPortType_Value_Wireless80211    NASPortType = 19
+)
+
+var NASPortType_Strings = map[NASPortType]string{
+	NASPortType_Value_Async:            "Async",
+	NASPortType_Value_Sync:             "Sync",
+	NASPortType_Value_ISDN:             "ISDN",
+	NASPortType_Value_ISDNV120:         "ISDN-V120",
+	NASPortType_Value_ISDNV110:         "ISDN-V110",
+	NASPortType_Value_Virtual:          "Virtual",
+	NASPortType_Value_PIAFS:            "PIAFS",
+	NASPortType_Value_HDLCClearChannel: "HDLC-Clear-Channel",
+	NASPortType_Value_X25:              "X.25",
+	NASPortType_Value_X75:              "X.75",
+	NASPortType_Value_G3Fax:            "G.3-Fax",
+	NASPortType_Value_SDSL:             "SDSL",
+	NASPortType_Value_ADSLCAP:          "ADSL-CAP",
+	NASPortType_Value_ADSLDMT:          "ADSL-DMT",
+	NASPortType_Value_IDSL:             "IDSL",
+	NASPortType_Value_Ethernet:         "Ethernet",
+	NASPortType_Value_XDSL:             "xDSL",
+	NASPortType_Value_Cable:            "Cable",
+	NASPortType_Value_WirelessOther:    "Wireless-Other",
+	NASPortType_Value_Wireless80211:    "Wireless-802.11",
+}
+
+func (a NASPortType) String() string {
+	if str, ok := NASPortType_Strings[a]; ok {
+		return str
+	}
+	return "NASPortType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// NASPortType_Get returns the first NAS-Port-Type of p, or ErrNoAttribute.
+func (p *Packet) NASPortType_Get() (value NASPortType, err error) {
+	value, ok, err := p.NASPortType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// NASPortType_Gets returns every NAS-Port-Type of p.
+func (p *Packet) NASPortType_Gets() (values []NASPortType, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASPortType_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value NASPortType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: NAS-Port-Type: %w", err)
+			return
+		}
+		value = NASPortType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// NASPortType_Lookup returns the first NAS-Port-Type of p. ok is false if there is none.
+func (p *Packet) NASPortType_Lookup() (value NASPortType, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASPortType_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-Port-Type: %w", err)
+		return
+	}
+	value = NASPortType(i)
+	return
+}
+
+// NASPortType_Set replaces every NAS-Port-Type of p with value.
+func (p *Packet) NASPortType_Set(value NASPortType) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(NASPortType_Type, a)
+	return
+}
+
+// NASPortType_Add appends value as a NAS-Port-Type to p.
+func (p *Packet) NASPortType_Add(value NASPortType) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(NASPortType_Type, a)
+	return
+}
+
+// NASPortType_Del removes every NAS-Port-Type from p.
+func (p *Packet) NASPortType_Del() {
+	p.Attributes.Del(NASPortType_Type)
+}
+
+// PortLimit_Get returns the first Port-Limit of p, or ErrNoAttribute.
+func (p *Packet) PortLimit_Get() (value uint32, err error) {
+	value, ok, err := p.PortLimit_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// PortLimit_Gets returns every Port-Limit of p.
+func (p *Packet) PortLimit_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != PortLimit_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Port-Limit: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// PortLimit_Lookup returns the first Port-Limit of p. ok is false if there is none.
+func (p *Packet) PortLimit_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(PortLimit_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Port-Limit: %w", err)
+		return
+	}
+	return
+}
+
+// PortLimit_Set replaces every Port-Limit of p with value.
+func (p *Packet) PortLimit_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(PortLimit_Type, a)
+	return
+}
+
+// PortLimit_Add appends value as a Port-Limit to p.
+func (p *Packet) PortLimit_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(PortLimit_Type, a)
+	return
+}
+
+// PortLimit_Del removes every Port-Limit from p.
+func (p *Packet) PortLimit_Del() {
+	p.Attributes.Del(PortLimit_Type)
+}
+
+// LoginLATPort_Get returns the first Login-LAT-Port of p, or ErrNoAttribute.
+func (p *Packet) LoginLATPort_Get() (value string, err error) {
+	value, ok, err := p.LoginLATPort_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginLATPort_Gets returns every Login-LAT-Port of p.
+func (p *Packet) LoginLATPort_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginLATPort_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginLATPort_Lookup returns the first Login-LAT-Port of p. ok is false if there is none.
+func (p *Packet) LoginLATPort_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginLATPort_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// LoginLATPort_Set replaces every Login-LAT-Port of p with value.
+func (p *Packet) LoginLATPort_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Port: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginLATPort_Type, a)
+	return
+}
+
+// LoginLATPort_Add appends value as a Login-LAT-Port to p.
+func (p *Packet) LoginLATPort_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-LAT-Port: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginLATPort_Type, a)
+	return
+}
+
+// LoginLATPort_Del removes every Login-LAT-Port from p.
+func (p *Packet) LoginLATPort_Del() {
+	p.Attributes.Del(LoginLATPort_Type)
+}
+
+type AcctStatusType uint32
+
+const (
+	AcctStatusType_Value_Start         AcctStatusType = 1
+	AcctStatusType_Value_Stop          AcctStatusType = 2
+	AcctStatusType_Value_InterimUpdate AcctStatusType = 3
+	AcctStatusType_Value_AccountingOn  AcctStatusType = 7
+	AcctStatusType_Value_AccountingOff AcctStatusType = 8
+)
+
+var AcctStatusType_Strings = map[AcctStatusType]string{
+	AcctStatusType_Value_Start:         "Start",
+	AcctStatusType_Value_Stop:          "Stop",
+	AcctStatusType_Value_InterimUpdate: "Interim-Update",
+	AcctStatusType_Value_AccountingOn:  "Accounting-On",
+	AcctStatusType_Value_AccountingOff: "Accounting-Off",
+}
+
+func (a AcctStatusType) String() string {
+	if str, ok := AcctStatusType_Strings[a]; ok {
+		return str
+	}
+	return "AcctStatusType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// AcctStatusType_Get returns the first Acct-Status-Type of p, or ErrNoAttribute.
+func (p *Packet) AcctStatusType_Get() (value AcctStatusType, err error) {
+	value, ok, err := p.AcctStatusType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctStatusType_Gets returns every Acct-Status-Type of p.
+func (p *Packet) AcctStatusType_Gets() (values []AcctStatusType, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctStatusType_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value AcctStatusType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Status-Type: %w", err)
+			return
+		}
+		value = AcctStatusType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctStatusType_Lookup returns the first Acct-Status-Type of p. ok is false if there is none.
+func (p *Packet) AcctStatusType_Lookup() (value AcctStatusType, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctStatusType_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Status-Type: %w", err)
+		return
+	}
+	value = AcctStatusType(i)
+	return
+}
+
+// AcctStatusType_Set replaces every Acct-Status-Type of p with value.
+func (p *Packet) AcctStatusType_Set(value AcctStatusType) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(AcctStatusType_Type, a)
+	return
+}
+
+// AcctStatusType_Add appends value as a Acct-Status-Type to p.
+func (p *Packet) AcctStatusType_Add(value AcctStatusType) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(AcctStatusType_Type, a)
+	return
+}
+
+// AcctStatusType_Del removes every Acct-Status-Type from p.
+func (p *Packet) AcctStatusType_Del() {
+	p.Attributes.Del(AcctStatusType_Type)
+}
+
+// AcctDelayTime_Get returns the first Acct-Delay-Time of p, or ErrNoAttribute.
+func (p *Packet) AcctDelayTime_Get() (value uint32, err error) {
+	value, ok, err := p.AcctDelayTime_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctDelayTime_Gets returns every Acct-Delay-Time of p.
+func (p *Packet) AcctDelayTime_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctDelayTime_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Delay-Time: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctDelayTime_Lookup returns the first Acct-Delay-Time of p. ok is false if there is none.
+func (p *Packet) AcctDelayTime_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctDelayTime_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Delay-Time: %w", err)
+		return
+	}
+	return
+}
+
+// AcctDelayTime_Set replaces every Acct-Delay-Time of p with value.
+func (p *Packet) AcctDelayTime_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctDelayTime_Type, a)
+	return
+}
+
+// AcctDelayTime_Add appends value as a Acct-Delay-Time to p.
+func (p *Packet) AcctDelayTime_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctDelayTime_Type, a)
+	return
+}
+
+// AcctDelayTime_Del removes every Acct-Delay-Time from p.
+func (p *Packet) AcctDelayTime_Del() {
+	p.Attributes.Del(AcctDelayTime_Type)
+}
+
+// AcctInputOctets_Get returns the first Acct-Input-Octets of p, or ErrNoAttribute.
+func (p *Packet) AcctInputOctets_Get() (value uint32, err error) {
+	value, ok, err := p.AcctInputOctets_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctInputOctets_Gets returns every Acct-Input-Octets of p.
+func (p *Packet) AcctInputOctets_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctInputOctets_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Input-Octets: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctInputOctets_Lookup returns the first Acct-Input-Octets of p. ok is false if there is none.
+func (p *Packet) AcctInputOctets_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctInputOctets_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Input-Octets: %w", err)
+		return
+	}
+	return
+}
+
+// AcctInputOctets_Set replaces every Acct-Input-Octets of p with value.
+func (p *Packet) AcctInputOctets_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctInputOctets_Type, a)
+	return
+}
+
+// AcctInputOctets_Add appends value as a Acct-Input-Octets to p.
+func (p *Packet) AcctInputOctets_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctInputOctets_Type, a)
+	return
+}
+
+// AcctInputOctets_Del removes every Acct-Input-Octets from p.
+func (p *Packet) AcctInputOctets_Del() {
+	p.Attributes.Del(AcctInputOctets_Type)
+}
+
+// AcctOutputOctets_Get returns the first Acct-Output-Octets of p, or ErrNoAttribute.
+func (p *Packet) AcctOutputOctets_Get() (value uint32, err error) {
+	value, ok, err := p.AcctOutputOctets_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctOutputOctets_Gets returns every Acct-Output-Octets of p.
+func (p *Packet) AcctOutputOctets_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctOutputOctets_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Output-Octets: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctOutputOctets_Lookup returns the first Acct-Output-Octets of p. ok is false if there is none.
+func (p *Packet) AcctOutputOctets_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctOutputOctets_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Output-Octets: %w", err)
+		return
+	}
+	return
+}
+
+// AcctOutputOctets_Set replaces every Acct-Output-Octets of p with value.
+func (p *Packet) AcctOutputOctets_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctOutputOctets_Type, a)
+	return
+}
+
+// AcctOutputOctets_Add appends value as a Acct-Output-Octets to p.
+func (p *Packet) AcctOutputOctets_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctOutputOctets_Type, a)
+	return
+}
+
+// AcctOutputOctets_Del removes every Acct-Output-Octets from p.
+func (p *Packet) AcctOutputOctets_Del() {
+	p.Attributes.Del(AcctOutputOctets_Type)
+}
+
+// AcctSessionID_Get returns the first Acct-Session-Id of p, or ErrNoAttribute.
+func (p *Packet) AcctSessionID_Get() (value string, err error) {
+	value, ok, err := p.AcctSessionID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctSessionID_Gets returns every Acct-Session-Id of p.
+func (p *Packet) AcctSessionID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctSessionID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctSessionID_Lookup returns the first Acct-Session-Id of p. ok is false if there is none.
+func (p *Packet) AcctSessionID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctSessionID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// AcctSessionID_Set replaces every Acct-Session-Id of p with value.
+func (p *Packet) AcctSessionID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Session-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(AcctSessionID_Type, a)
+	return
+}
+
+// AcctSessionID_Add appends value as a Acct-Session-Id to p.
+func (p *Packet) AcctSessionID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Session-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(AcctSessionID_Type, a)
+	return
+}
+
+// AcctSessionID_Del removes every Acct-Session-Id from p.
+func (p *Packet) AcctSessionID_Del() {
+	p.Attributes.Del(AcctSessionID_Type)
+}
+
+type AcctAuthentic uint32
+
+const (
+	AcctAuthentic_Value_RADIUS   AcctAuthentic = 1
+	AcctAuthentic_Value_Local    AcctAuthentic = 2
+	AcctAuthentic_Value_Remote   AcctAuthentic = 3
+	AcctAuthentic_Value_Diameter AcctAuthentic = 4
+)
+
+var AcctAuthentic_Strings = map[AcctAuthentic]string{
+	AcctAuthentic_Value_RADIUS:   "RADIUS",
+	AcctAuthentic_Value_Local:    "Local",
+	AcctAuthentic_Value_Remote:   "Remote",
+	AcctAuthentic_Value_Diameter: "Diameter",
+}
+
+func (a AcctAuthentic) String() string {
+	if str, ok := AcctAuthentic_Strings[a]; ok {
+		return str
+	}
+	return "AcctAuthentic(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// AcctAuthentic_Get returns the first Acct-Authentic of p, or ErrNoAttribute.
+func (p *Packet) AcctAuthentic_Get() (value AcctAuthentic, err error) {
+	value, ok, err := p.AcctAuthentic_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctAuthentic_Gets returns every Acct-Authentic of p.
+func (p *Packet) AcctAuthentic_Gets() (values []AcctAuthentic, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctAuthentic_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value AcctAuthentic
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Authentic: %w", err)
+			return
+		}
+		value = AcctAuthentic(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctAuthentic_Lookup returns the first Acct-Authentic of p. ok is false if there is none.
+func (p *Packet) AcctAuthentic_Lookup() (value AcctAuthentic, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctAuthentic_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Authentic: %w", err)
+		return
+	}
+	value = AcctAuthentic(i)
+	return
+}
+
+// AcctAuthentic_Set replaces every Acct-Authentic of p with value.
+func (p *Packet) AcctAuthentic_Set(value AcctAuthentic) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(AcctAuthentic_Type, a)
+	return
+}
+
+// AcctAuthentic_Add appends value as a Acct-Authentic to p.
+func (p *Packet) AcctAuthentic_Add(value AcctAuthentic) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(AcctAuthentic_Type, a)
+	return
+}
+
+// AcctAuthentic_Del removes every Acct-Authentic from p.
+func (p *Packet) AcctAuthentic_Del() {
+	p.Attributes.Del(AcctAuthentic_Type)
+}
+
+// AcctSessionTime_Get returns the first Acct-Session-Time of p, or ErrNoAttribute.
+func (p *Packet) AcctSessionTime_Get() (value uint32, err error) {
+	value, ok, err := p.AcctSessionTime_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctSessionTime_Gets returns every Acct-Session-Time of p.
+func (p *Packet) AcctSessionTime_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctSessionTime_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Session-Time: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctSessionTime_Lookup returns the first Acct-Session-Time of p. ok is false if there is none.
+func (p *Packet) AcctSessionTime_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctSessionTime_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Session-Time: %w", err)
+		return
+	}
+	return
+}
+
+// AcctSessionTime_Set replaces every Acct-Session-Time of p with value.
+func (p *Packet) AcctSessionTime_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctSessionTime_Type, a)
+	return
+}
+
+// AcctSessionTime_Add appends value as a Acct-Session-Time to p.
+func (p *Packet) AcctSessionTime_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctSessionTime_Type, a)
+	return
+}
+
+// AcctSessionTime_Del removes every Acct-Session-Time from p.
+func (p *Packet) AcctSessionTime_Del() {
+	p.Attributes.Del(AcctSessionTime_Type)
+}
+
+// AcctInputPackets_Get returns the first Acct-Input-Packets of p, or ErrNoAttribute.
+func (p *Packet) AcctInputPackets_Get() (value uint32, err error) {
+	value, ok, err := p.AcctInputPackets_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctInputPackets_Gets returns every Acct-Input-Packets of p.
+func (p *Packet) AcctInputPackets_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctInputPackets_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Input-Packets: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctInputPackets_Lookup returns the first Acct-Input-Packets of p. ok is false if there is none.
+func (p *Packet) AcctInputPackets_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctInputPackets_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Input-Packets: %w", err)
+		return
+	}
+	return
+}
+
+// AcctInputPackets_Set replaces every Acct-Input-Packets of p with value.
+func (p *Packet) AcctInputPackets_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctInputPackets_Type, a)
+	return
+}
+
+// AcctInputPackets_Add appends value as a Acct-Input-Packets to p.
+func (p *Packet) AcctInputPackets_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctInputPackets_Type, a)
+	return
+}
+
+// AcctInputPackets_Del removes every Acct-Input-Packets from p.
+func (p *Packet) AcctInputPackets_Del() {
+	p.Attributes.Del(AcctInputPackets_Type)
+}
+
+// AcctOutputPackets_Get returns the first Acct-Output-Packets of p, or ErrNoAttribute.
+func (p *Packet) AcctOutputPackets_Get() (value uint32, err error) {
+	value, ok, err := p.AcctOutputPackets_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctOutputPackets_Gets returns every Acct-Output-Packets of p.
+func (p *Packet) AcctOutputPackets_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctOutputPackets_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Output-Packets: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctOutputPackets_Lookup returns the first Acct-Output-Packets of p. ok is false if there is none.
+func (p *Packet) AcctOutputPackets_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctOutputPackets_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Output-Packets: %w", err)
+		return
+	}
+	return
+}
+
+// AcctOutputPackets_Set replaces every Acct-Output-Packets of p with value.
+func (p *Packet) AcctOutputPackets_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctOutputPackets_Type, a)
+	return
+}
+
+// AcctOutputPackets_Add appends value as a Acct-Output-Packets to p.
+func (p *Packet) AcctOutputPackets_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctOutputPackets_Type, a)
+	return
+}
+
+// AcctOutputPackets_Del removes every Acct-Output-Packets from p.
+func (p *Packet) AcctOutputPackets_Del() {
+	p.Attributes.Del(AcctOutputPackets_Type)
+}
+
+type AcctTerminateCause uint32
+
+const (
+	AcctTerminateCause_Value_UserRequest        AcctTerminateCause = 1
+	AcctTerminateCause_Value_LostCarrier        AcctTerminateCause = 2
+	AcctTerminateCause_Value_LostService        AcctTerminateCause = 3
+	AcctTerminateCause_Value_IdleTimeout        AcctTerminateCause = 4
+	AcctTerminateCause_Value_SessionTimeout     AcctTerminateCause = 5
+	AcctTerminateCause_Value_AdminReset         AcctTerminateCause = 6
+	AcctTerminateCause_Value_AdminReboot        AcctTerminateCause = 7
+	AcctTerminateCause_Value_PortError          AcctTerminateCause = 8
+	AcctTerminateCause_Value_NASError           AcctTerminateCause = 9
+	AcctTerminateCause_Value_NASRequest         AcctTerminateCause = 10
+	AcctTerminateCause_Value_NASReboot          AcctTerminateCause = 11
+	AcctTerminateCause_Value_PortUnneeded       AcctTerminateCause = 12
+	AcctTerminateCause_Value_PortPreempted      AcctTerminateCause = 13
+	AcctTerminateCause_Value_PortSuspended      AcctTerminateCause = 14
+	AcctTerminateCause_Value_ServiceUnavailable AcctTerminateCause = 15
+	AcctTerminateCause_Value_Callback           AcctTerminateCause = 16
+	AcctTerminateCause_Value_UserError          AcctTerminateCause = 17
+	AcctTerminateCause_Value_HostRequest        AcctTerminateCause = 18
+)
+
+var AcctTerminateCause_Strings = map[AcctTerminateCause]string{
+	AcctTerminateCause_Value_UserRequest:        "User-Request",
+	AcctTerminateCause_Value_LostCarrier:        "Lost-Carrier",
+	AcctTerminateCause_Value_LostService:        "Lost-Service",
+	AcctTerminateCause_Value_IdleTimeout:        "Idle-Timeout",
+	AcctTerminateCause_Value_SessionTimeout:     "Session-Timeout",
+	AcctTerminateCause_Value_AdminReset:         "Admin-Reset",
+	AcctTerminateCause_Value_AdminReboot:        "Admin-Reboot",
+	AcctTerminateCause_Value_PortError:          "Port-Error",
+	AcctTerminateCause_Value_NASError:           "NAS-Error",
+	AcctTerminateCause_Value_NASRequest:         "NAS-Request",
+	AcctTerminateCause_Value_NASReboot:          "NAS-Reboot",
+	AcctTerminateCause_Value_PortUnneeded:       "Port-Unneeded",
+	AcctTerminateCause_Value_PortPreempted:      "Port-Preempted",
+	AcctTerminateCause_Value_PortSuspended:      "Port-Suspended",
+	AcctTerminateCause_Value_ServiceUnavailable: "Service-Unavailable",
+	AcctTerminateCause_Value_Callback:           "Callback",
+	AcctTerminateCause_Value_UserError:          "User-Error",
+	AcctTerminateCause_Value_HostRequest:        "Host-Request",
+}
+
+func (a AcctTerminateCause) String() string {
+	if str, ok := AcctTerminateCause_Strings[a]; ok {
+		return str
+	}
+	return "AcctTerminateCause(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// AcctTerminateCause_Get returns the first Acct-Terminate-Cause of p, or ErrNoAttribute.
+func (p *Packet) AcctTerminateCause_Get() (value AcctTerminateCause, err error) {
+	value, ok, err := p.AcctTerminateCause_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctTerminateCause_Gets returns every Acct-Terminate-Cause of p.
+func (p *Packet) AcctTerminateCause_Gets() (values []AcctTerminateCause, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctTerminateCause_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value AcctTerminateCause
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Terminate-Cause: %w", err)
+			return
+		}
+		value = AcctTerminateCause(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctTerminateCause_Lookup returns the first Acct-Terminate-Cause of p. ok is false if there is none.
+func (p *Packet) AcctTerminateCause_Lookup() (value AcctTerminateCause, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctTerminateCause_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Terminate-Cause: %w", err)
+		return
+	}
+	value = AcctTerminateCause(i)
+	return
+}
+
+// AcctTerminateCause_Set replaces every Acct-Terminate-Cause of p with value.
+func (p *Packet) AcctTerminateCause_Set(value AcctTerminateCause) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(AcctTerminateCause_Type, a)
+	return
+}
+
+// AcctTerminateCause_Add appends value as a Acct-Terminate-Cause to p.
+func (p *Packet) AcctTerminateCause_Add(value AcctTerminateCause) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(AcctTerminateCause_Type, a)
+	return
+}
+
+// AcctTerminateCause_Del removes every Acct-Terminate-Cause from p.
+func (p *Packet) AcctTerminateCause_Del() {
+	p.Attributes.Del(AcctTerminateCause_Type)
+}
+
+// AcctMultiSessionID_Get returns the first Acct-Multi-Session-Id of p, or ErrNoAttribute.
+func (p *Packet) AcctMultiSessionID_Get() (value string, err error) {
+	value, ok, err := p.AcctMultiSessionID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctMultiSessionID_Gets returns every Acct-Multi-Session-Id of p.
+func (p *Packet) AcctMultiSessionID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctMultiSessionID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctMultiSessionID_Lookup returns the first Acct-Multi-Session-Id of p. ok is false if there is none.
+func (p *Packet) AcctMultiSessionID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctMultiSessionID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// AcctMultiSessionID_Set replaces every Acct-Multi-Session-Id of p with value.
+func (p *Packet) AcctMultiSessionID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Multi-Session-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(AcctMultiSessionID_Type, a)
+	return
+}
+
+// AcctMultiSessionID_Add appends value as a Acct-Multi-Session-Id to p.
+func (p *Packet) AcctMultiSessionID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Multi-Session-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(AcctMultiSessionID_Type, a)
+	return
+}
+
+// AcctMultiSessionID_Del removes every Acct-Multi-Session-Id from p.
+func (p *Packet) AcctMultiSessionID_Del() {
+	p.Attributes.Del(AcctMultiSessionID_Type)
+}
+
+// AcctLinkCount_Get returns the first Acct-Link-Count of p, or ErrNoAttribute.
+func (p *Packet) AcctLinkCount_Get() (value uint32, err error) {
+	value, ok, err := p.AcctLinkCount_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctLinkCount_Gets returns every Acct-Link-Count of p.
+func (p *Packet) AcctLinkCount_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctLinkCount_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Link-Count: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctLinkCount_Lookup returns the first Acct-Link-Count of p. ok is false if there is none.
+func (p *Packet) AcctLinkCount_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctLinkCount_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Link-Count: %w", err)
+		return
+	}
+	return
+}
+
+// AcctLinkCount_Set replaces every Acct-Link-Count of p with value.
+func (p *Packet) AcctLinkCount_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctLinkCount_Type, a)
+	return
+}
+
+// AcctLinkCount_Add appends value as a Acct-Link-Count to p.
+func (p *Packet) AcctLinkCount_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctLinkCount_Type, a)
+	return
+}
+
+// AcctLinkCount_Del removes every Acct-Link-Count from p.
+func (p *Packet) AcctLinkCount_Del() {
+	p.Attributes.Del(AcctLinkCount_Type)
+}
+
+type TunnelType uint32
+
+const (
+	TunnelType_Value_PPTP   TunnelType = 1
+	TunnelType_Value_L2F    TunnelType = 2
+	TunnelType_Value_L2TP   TunnelType = 3
+	TunnelType_Value_ATMP   TunnelType = 4
+	TunnelType_Value_VTP    TunnelType = 5
+	TunnelType_Value_AH     TunnelType = 6
+	TunnelType_Value_IP     TunnelType = 7
+	TunnelType_Value_MINIP  TunnelType = 8
+	TunnelType_Value_ESP    TunnelType = 9
+	TunnelType_Value_GRE    TunnelType = 10
+	TunnelType_Value_DVS    TunnelType = 11
+	TunnelType_Value_IPInIP TunnelType = 12
+	TunnelType_Value_VLAN   TunnelType = 13
+)
+
+var TunnelType_Strings = map[TunnelType]string{
+	TunnelType_Value_PPTP:   "PPTP",
+	TunnelType_Value_L2F:    "L2F",
+	TunnelType_Value_L2TP:   "L2TP",
+	TunnelType_Value_ATMP:   "ATMP",
+	TunnelType_Value_VTP:    "VTP",
+	TunnelType_Value_AH:     "AH",
+	TunnelType_Value_IP:     "IP",
+	TunnelType_Value_MINIP:  "MIN-IP",
+	TunnelType_Value_ESP:    "ESP",
+	TunnelType_Value_GRE:    "GRE",
+	TunnelType_Value_DVS:    "DVS",
+	TunnelType_Value_IPInIP: "IP-in-IP",
+	TunnelType_Value_VLAN:   "VLAN",
+}
+
+func (a TunnelType) String() string {
+	if str, ok := TunnelType_Strings[a]; ok {
+		return str
+	}
+	return "TunnelType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// TunnelType_Get returns the first Tunnel-Type of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelType_Get(tag byte) (value TunnelType, err error) {
+	value, ok, err := p.TunnelType_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelType_Gets returns every Tunnel-Type of p with the given tag.
+func (p *Packet) TunnelType_Gets(tag byte) (values []TunnelType, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelType_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		var value TunnelType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Type: %w", err)
+			return
+		}
+		value = TunnelType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelType_Lookup returns the first Tunnel-Type of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelType_Lookup(tag byte) (value TunnelType, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelType_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		ok = true
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Type: %w", err)
+			return
+		}
+		value = TunnelType(i)
+		return
+	}
+	return
+}
+
+// TunnelType_Set replaces every Tunnel-Type of p with the given tag by value.
+func (p *Packet) TunnelType_Set(tag byte, value TunnelType) (err error) {
+	p.TunnelType_Del(tag)
+	return p.TunnelType_Add(tag, value)
+}
+
+// TunnelType_Add appends value as a Tunnel-Type with the given tag to p.
+func (p *Packet) TunnelType_Add(tag byte, value TunnelType) (err error) {
+	a := NewInteger(uint32(value))
+	a, err = JoinTag(tag, a, true)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Type: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelType_Type, a)
+	return
+}
+
+// TunnelType_Del removes every Tunnel-Type with the given tag from p.
+func (p *Packet) TunnelType_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, true); avp.Type == TunnelType_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+type TunnelMediumType uint32
+
+const (
+	TunnelMediumType_Value_IPv4        TunnelMediumType = 1
+	TunnelMediumType_Value_IPv6        TunnelMediumType = 2
+	TunnelMediumType_Value_NSAP        TunnelMediumType = 3
+	TunnelMediumType_Value_HDLC        TunnelMediumType = 4
+	TunnelMediumType_Value_BBN1822     TunnelMediumType = 5
+	TunnelMediumType_Value_IEEE802     TunnelMediumType = 6
+	TunnelMediumType_Value_E163        TunnelMediumType = 7
+	TunnelMediumType_Value_E164        TunnelMediumType = 8
+	TunnelMediumType_Value_F69         TunnelMediumType = 9
+	TunnelMediumType_Value_X121        TunnelMediumType = 10
+	TunnelMediumType_Value_IPX         TunnelMediumType = 11
+	TunnelMediumType_Value_Appletalk   TunnelMediumType = 12
+	TunnelMediumType_Value_DecNetIV    TunnelMediumType = 13
+	TunnelMediumType_Value_BanyanVines TunnelMediumType = 14
+	TunnelMediumType_Value_E164NSAP    TunnelMediumType = 15
+)
+
+var TunnelMediumType_Strings = map[TunnelMediumType]string{
+	TunnelMediumType_Value_IPv4:        "IPv4",
+	TunnelMediumType_Value_IPv6:        "IPv6",
+	TunnelMediumType_Value_NSAP:        "NSAP",
+	TunnelMediumType_Value_HDLC:        "HDLC",
+	TunnelMediumType_Value_BBN1822:     "BBN-1822",
+	TunnelMediumType_Value_IEEE802:     "IEEE-802",
+	TunnelMediumType_Value_E163:        "E.163",
+	TunnelMediumType_Value_E164:        "E.164",
+	TunnelMediumType_Value_F69:         "F.69",
+	TunnelMediumType_Value_X121:        "X.121",
+	TunnelMediumType_Value_IPX:         "IPX",
+	TunnelMediumType_Value_Appletalk:   "Appletalk",
+	TunnelMediumType_Value_DecNetIV:    "DecNet-IV",
+	TunnelMediumType_Value_BanyanVines: "Banyan-Vines",
+	TunnelMediumType_Value_E164NSAP:    "E.164-NSAP",
+}
+
+func (a TunnelMediumType) String() string {
+	if str, ok := TunnelMediumType_Strings[a]; ok {
+		return str
+	}
+	return "TunnelMediumType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// TunnelMediumType_Get returns the first Tunnel-Medium-Type of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelMediumType_Get(tag byte) (value TunnelMediumType, err error) {
+	value, ok, err := p.TunnelMediumType_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelMediumType_Gets returns every Tunnel-Medium-Type of p with the given tag.
+func (p *Packet) TunnelMediumType_Gets(tag byte) (values []TunnelMediumType, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelMediumType_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		var value TunnelMediumType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Medium-Type: %w", err)
+			return
+		}
+		value = TunnelMediumType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelMediumType_Lookup returns the first Tunnel-Medium-Type of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelMediumType_Lookup(tag byte) (value TunnelMediumType, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelMediumType_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		ok = true
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Medium-Type: %w", err)
+			return
+		}
+		value = TunnelMediumType(i)
+		return
+	}
+	return
+}
+
+// TunnelMediumType_Set replaces every Tunnel-Medium-Type of p with the given tag by value.
+func (p *Packet) TunnelMediumType_Set(tag byte, value TunnelMediumType) (err error) {
+	p.TunnelMediumType_Del(tag)
+	return p.TunnelMediumType_Add(tag, value)
+}
+
+// TunnelMediumType_Add appends value as a Tunnel-Medium-Type with the given tag to p.
+func (p *Packet) TunnelMediumType_Add(tag byte, value TunnelMediumType) (err error) {
+	a := NewInteger(uint32(value))
+	a, err = JoinTag(tag, a, true)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Medium-Type: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelMediumType_Type, a)
+	return
+}
+
+// TunnelMediumType_Del removes every Tunnel-Medium-Type with the given tag from p.
+func (p *Packet) TunnelMediumType_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, true); avp.Type == TunnelMediumType_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelClientEndpoint_Get returns the first Tunnel-Client-Endpoint of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelClientEndpoint_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelClientEndpoint_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelClientEndpoint_Gets returns every Tunnel-Client-Endpoint of p with the given tag.
+func (p *Packet) TunnelClientEndpoint_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelClientEndpoint_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelClientEndpoint_Lookup returns the first Tunnel-Client-Endpoint of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelClientEndpoint_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelClientEndpoint_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelClientEndpoint_Set replaces every Tunnel-Client-Endpoint of p with the given tag by value.
+func (p *Packet) TunnelClientEndpoint_Set(tag byte, value string) (err error) {
+	p.TunnelClientEndpoint_Del(tag)
+	return p.TunnelClientEndpoint_Add(tag, value)
+}
+
+// TunnelClientEndpoint_Add appends value as a Tunnel-Client-Endpoint with the given tag to p.
+func (p *Packet) TunnelClientEndpoint_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Client-Endpoint: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Client-Endpoint: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelClientEndpoint_Type, a)
+	return
+}
+
+// TunnelClientEndpoint_Del removes every Tunnel-Client-Endpoint with the given tag from p.
+func (p *Packet) TunnelClientEndpoint_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelClientEndpoint_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelServerEndpoint_Get returns the first Tunnel-Server-Endpoint of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelServerEndpoint_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelServerEndpoint_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelServerEndpoint_Gets returns every Tunnel-Server-Endpoint of p with the given tag.
+func (p *Packet) TunnelServerEndpoint_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelServerEndpoint_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelServerEndpoint_Lookup returns the first Tunnel-Server-Endpoint of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelServerEndpoint_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelServerEndpoint_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelServerEndpoint_Set replaces every Tunnel-Server-Endpoint of p with the given tag by value.
+func (p *Packet) TunnelServerEndpoint_Set(tag byte, value string) (err error) {
+	p.TunnelServerEndpoint_Del(tag)
+	return p.TunnelServerEndpoint_Add(tag, value)
+}
+
+// TunnelServerEndpoint_Add appends value as a Tunnel-Server-Endpoint with the given tag to p.
+func (p *Packet) TunnelServerEndpoint_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Server-Endpoint: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Server-Endpoint: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelServerEndpoint_Type, a)
+	return
+}
+
+// TunnelServerEndpoint_Del removes every Tunnel-Server-Endpoint with the given tag from p.
+func (p *Packet) TunnelServerEndpoint_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelServerEndpoint_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelPrivateGroupID_Get returns the first Tunnel-Private-Group-Id of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelPrivateGroupID_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelPrivateGroupID_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelPrivateGroupID_Gets returns every Tunnel-Private-Group-Id of p with the given tag.
+func (p *Packet) TunnelPrivateGroupID_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelPrivateGroupID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelPrivateGroupID_Lookup returns the first Tunnel-Private-Group-Id of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelPrivateGroupID_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelPrivateGroupID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelPrivateGroupID_Set replaces every Tunnel-Private-Group-Id of p with the given tag by value.
+func (p *Packet) TunnelPrivateGroupID_Set(tag byte, value string) (err error) {
+	p.TunnelPrivateGroupID_Del(tag)
+	return p.TunnelPrivateGroupID_Add(tag, value)
+}
+
+// TunnelPrivateGroupID_Add appends value as a Tunnel-Private-Group-Id with the given tag to p.
+func (p *Packet) TunnelPrivateGroupID_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Private-Group-Id: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Private-Group-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelPrivateGroupID_Type, a)
+	return
+}
+
+// TunnelPrivateGroupID_Del removes every Tunnel-Private-Group-Id with the given tag from p.
+func (p *Packet) TunnelPrivateGroupID_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelPrivateGroupID_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelAssignmentID_Get returns the first Tunnel-Assignment-Id of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelAssignmentID_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelAssignmentID_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelAssignmentID_Gets returns every Tunnel-Assignment-Id of p with the given tag.
+func (p *Packet) TunnelAssignmentID_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelAssignmentID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelAssignmentID_Lookup returns the first Tunnel-Assignment-Id of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelAssignmentID_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelAssignmentID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelAssignmentID_Set replaces every Tunnel-Assignment-Id of p with the given tag by value.
+func (p *Packet) TunnelAssignmentID_Set(tag byte, value string) (err error) {
+	p.TunnelAssignmentID_Del(tag)
+	return p.TunnelAssignmentID_Add(tag, value)
+}
+
+// TunnelAssignmentID_Add appends value as a Tunnel-Assignment-Id with the given tag to p.
+func (p *Packet) TunnelAssignmentID_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Assignment-Id: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Assignment-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelAssignmentID_Type, a)
+	return
+}
+
+// TunnelAssignmentID_Del removes every Tunnel-Assignment-Id with the given tag from p.
+func (p *Packet) TunnelAssignmentID_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelAssignmentID_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelPreference_Get returns the first Tunnel-Preference of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelPreference_Get(tag byte) (value uint32, err error) {
+	value, ok, err := p.TunnelPreference_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelPreference_Gets returns every Tunnel-Preference of p with the given tag.
+func (p *Packet) TunnelPreference_Gets(tag byte) (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelPreference_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Preference: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelPreference_Lookup returns the first Tunnel-Preference of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelPreference_Lookup(tag byte) (value uint32, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelPreference_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, true)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Tunnel-Preference: %w", err)
+			return
+		}
+		return
+	}
+	return
+}
+
+// TunnelPreference_Set replaces every Tunnel-Preference of p with the given tag by value.
+func (p *Packet) TunnelPreference_Set(tag byte, value uint32) (err error) {
+	p.TunnelPreference_Del(tag)
+	return p.TunnelPreference_Add(tag, value)
+}
+
+// TunnelPreference_Add appends value as a Tunnel-Preference with the given tag to p.
+func (p *Packet) TunnelPreference_Add(tag byte, value uint32) (err error) {
+	a := NewInteger(value)
+	a, err = JoinTag(tag, a, true)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Preference: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelPreference_Type, a)
+	return
+}
+
+// TunnelPreference_Del removes every Tunnel-Preference with the given tag from p.
+func (p *Packet) TunnelPreference_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, true); avp.Type == TunnelPreference_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelClientAuthID_Get returns the first Tunnel-Client-Auth-Id of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelClientAuthID_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelClientAuthID_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelClientAuthID_Gets returns every Tunnel-Client-Auth-Id of p with the given tag.
+func (p *Packet) TunnelClientAuthID_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelClientAuthID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelClientAuthID_Lookup returns the first Tunnel-Client-Auth-Id of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelClientAuthID_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelClientAuthID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelClientAuthID_Set replaces every Tunnel-Client-Auth-Id of p with the given tag by value.
+func (p *Packet) TunnelClientAuthID_Set(tag byte, value string) (err error) {
+	p.TunnelClientAuthID_Del(tag)
+	return p.TunnelClientAuthID_Add(tag, value)
+}
+
+// TunnelClientAuthID_Add appends value as a Tunnel-Client-Auth-Id with the given tag to p.
+func (p *Packet) TunnelClientAuthID_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Client-Auth-Id: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Client-Auth-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelClientAuthID_Type, a)
+	return
+}
+
+// TunnelClientAuthID_Del removes every Tunnel-Client-Auth-Id with the given tag from p.
+func (p *Packet) TunnelClientAuthID_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelClientAuthID_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// TunnelServerAuthID_Get returns the first Tunnel-Server-Auth-Id of p with the given tag, or
+// ErrNoAttribute.
+func (p *Packet) TunnelServerAuthID_Get(tag byte) (value string, err error) {
+	value, ok, err := p.TunnelServerAuthID_Lookup(tag)
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// TunnelServerAuthID_Gets returns every Tunnel-Server-Auth-Id of p with the given tag.
+func (p *Packet) TunnelServerAuthID_Gets(tag byte) (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelServerAuthID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// TunnelServerAuthID_Lookup returns the first Tunnel-Server-Auth-Id of p with the given tag. ok is
+// false if there is none.
+func (p *Packet) TunnelServerAuthID_Lookup(tag byte) (value string, ok bool, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != TunnelServerAuthID_Type {
+			continue
+		}
+		t, a := SplitTag(avp.Attribute, false)
+		if t != tag {
+			continue
+		}
+		ok = true
+		value = String(a)
+		return
+	}
+	return
+}
+
+// TunnelServerAuthID_Set replaces every Tunnel-Server-Auth-Id of p with the given tag by value.
+func (p *Packet) TunnelServerAuthID_Set(tag byte, value string) (err error) {
+	p.TunnelServerAuthID_Del(tag)
+	return p.TunnelServerAuthID_Add(tag, value)
+}
+
+// TunnelServerAuthID_Add appends value as a Tunnel-Server-Auth-Id with the given tag to p.
+func (p *Packet) TunnelServerAuthID_Add(tag byte, value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Server-Auth-Id: %w", err)
+		return
+	}
+	a, err = JoinTag(tag, a, false)
+	if err != nil {
+		err = fmt.Errorf("radius: Tunnel-Server-Auth-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(TunnelServerAuthID_Type, a)
+	return
+}
+
+// TunnelServerAuthID_Del removes every Tunnel-Server-Auth-Id with the given tag from p.
+func (p *Packet) TunnelServerAuthID_Del(tag byte) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if t, _ := SplitTag(avp.Attribute, false); avp.Type == TunnelServerAuthID_Type && t == tag {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+// AcctInputGigawords_Get returns the first Acct-Input-Gigawords of p, or ErrNoAttribute.
+func (p *Packet) AcctInputGigawords_Get() (value uint32, err error) {
+	value, ok, err := p.AcctInputGigawords_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctInputGigawords_Gets returns every Acct-Input-Gigawords of p.
+func (p *Packet) AcctInputGigawords_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctInputGigawords_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Input-Gigawords: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctInputGigawords_Lookup returns the first Acct-Input-Gigawords of p. ok is false if there is none.
+func (p *Packet) AcctInputGigawords_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctInputGigawords_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Input-Gigawords: %w", err)
+		return
+	}
+	return
+}
+
+// AcctInputGigawords_Set replaces every Acct-Input-Gigawords of p with value.
+func (p *Packet) AcctInputGigawords_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctInputGigawords_Type, a)
+	return
+}
+
+// AcctInputGigawords_Add appends value as a Acct-Input-Gigawords to p.
+func (p *Packet) AcctInputGigawords_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctInputGigawords_Type, a)
+	return
+}
+
+// AcctInputGigawords_Del removes every Acct-Input-Gigawords from p.
+func (p *Packet) AcctInputGigawords_Del() {
+	p.Attributes.Del(AcctInputGigawords_Type)
+}
+
+// AcctOutputGigawords_Get returns the first Acct-Output-Gigawords of p, or ErrNoAttribute.
+func (p *Packet) AcctOutputGigawords_Get() (value uint32, err error) {
+	value, ok, err := p.AcctOutputGigawords_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctOutputGigawords_Gets returns every Acct-Output-Gigawords of p.
+func (p *Packet) AcctOutputGigawords_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctOutputGigawords_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Output-Gigawords: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctOutputGigawords_Lookup returns the first Acct-Output-Gigawords of p. ok is false if there is none.
+func (p *Packet) AcctOutputGigawords_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctOutputGigawords_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Output-Gigawords: %w", err)
+		return
+	}
+	return
+}
+
+// AcctOutputGigawords_Set replaces every Acct-Output-Gigawords of p with value.
+func (p *Packet) AcctOutputGigawords_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctOutputGigawords_Type, a)
+	return
+}
+
+// AcctOutputGigawords_Add appends value as a Acct-Output-Gigawords to p.
+func (p *Packet) AcctOutputGigawords_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctOutputGigawords_Type, a)
+	return
+}
+
+// AcctOutputGigawords_Del removes every Acct-Output-Gigawords from p.
+func (p *Packet) AcctOutputGigawords_Del() {
+	p.Attributes.Del(AcctOutputGigawords_Type)
+}
+
+// EventTimestamp_Get returns the first Event-Timestamp of p, or ErrNoAttribute.
+func (p *Packet) EventTimestamp_Get() (value time.Time, err error) {
+	value, ok, err := p.EventTimestamp_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// EventTimestamp_Gets returns every Event-Timestamp of p.
+func (p *Packet) EventTimestamp_Gets() (values []time.Time, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != EventTimestamp_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value time.Time
+		value, err = Date(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Event-Timestamp: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// EventTimestamp_Lookup returns the first Event-Timestamp of p. ok is false if there is none.
+func (p *Packet) EventTimestamp_Lookup() (value time.Time, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(EventTimestamp_Type)
+	if !ok {
+		return
+	}
+	value, err = Date(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Event-Timestamp: %w", err)
+		return
+	}
+	return
+}
+
+// EventTimestamp_Set replaces every Event-Timestamp of p with value.
+func (p *Packet) EventTimestamp_Set(value time.Time) (err error) {
+	a, err := NewDate(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Event-Timestamp: %w", err)
+		return
+	}
+	p.Attributes.Set(EventTimestamp_Type, a)
+	return
+}
+
+// EventTimestamp_Add appends value as a Event-Timestamp to p.
+func (p *Packet) EventTimestamp_Add(value time.Time) (err error) {
+	a, err := NewDate(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Event-Timestamp: %w", err)
+		return
+	}
+	p.Attributes.Add(EventTimestamp_Type, a)
+	return
+}
+
+// EventTimestamp_Del removes every Event-Timestamp from p.
+func (p *Packet) EventTimestamp_Del() {
+	p.Attributes.Del(EventTimestamp_Type)
+}
+
+// ARAPPassword_Get returns the first ARAP-Password of p, or ErrNoAttribute.
+func (p *Packet) ARAPPassword_Get() (value []byte, err error) {
+	value, ok, err := p.ARAPPassword_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPPassword_Gets returns every ARAP-Password of p.
+func (p *Packet) ARAPPassword_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPPassword_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPPassword_Lookup returns the first ARAP-Password of p. ok is false if there is none.
+func (p *Packet) ARAPPassword_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPPassword_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// ARAPPassword_Set replaces every ARAP-Password of p with value.
+func (p *Packet) ARAPPassword_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Password: %w", err)
+		return
+	}
+	p.Attributes.Set(ARAPPassword_Type, a)
+	return
+}
+
+// ARAPPassword_Add appends value as a ARAP-Password to p.
+func (p *Packet) ARAPPassword_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Password: %w", err)
+		return
+	}
+	p.Attributes.Add(ARAPPassword_Type, a)
+	return
+}
+
+// ARAPPassword_Del removes every ARAP-Password from p.
+func (p *Packet) ARAPPassword_Del() {
+	p.Attributes.Del(ARAPPassword_Type)
+}
+
+// ARAPFeatures_Get returns the first ARAP-Features of p, or ErrNoAttribute.
+func (p *Packet) ARAPFeatures_Get() (value []byte, err error) {
+	value, ok, err := p.ARAPFeatures_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPFeatures_Gets returns every ARAP-Features of p.
+func (p *Packet) ARAPFeatures_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPFeatures_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPFeatures_Lookup returns the first ARAP-Features of p. ok is false if there is none.
+func (p *Packet) ARAPFeatures_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPFeatures_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// ARAPFeatures_Set replaces every ARAP-Features of p with value.
+func (p *Packet) ARAPFeatures_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Features: %w", err)
+		return
+	}
+	p.Attributes.Set(ARAPFeatures_Type, a)
+	return
+}
+
+// ARAPFeatures_Add appends value as a ARAP-Features to p.
+func (p *Packet) ARAPFeatures_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Features: %w", err)
+		return
+	}
+	p.Attributes.Add(ARAPFeatures_Type, a)
+	return
+}
+
+// ARAPFeatures_Del removes every ARAP-Features from p.
+func (p *Packet) ARAPFeatures_Del() {
+	p.Attributes.Del(ARAPFeatures_Type)
+}
+
+type ARAPZoneAccess uint32
+
+const (
+	ARAPZoneAccess_Value_DefaultZone         ARAPZoneAccess = 1
+	ARAPZoneAccess_Value_ZoneFilterInclusive ARAPZoneAccess = 2
+	ARAPZoneAccess_Value_ZoneFilterExclusive ARAPZoneAccess = 4
+)
+
+var ARAPZoneAccess_Strings = map[ARAPZoneAccess]string{
+	ARAPZoneAccess_Value_DefaultZone:         "Default-Zone",
+	ARAPZoneAccess_Value_ZoneFilterInclusive: "Zone-Filter-Inclusive",
+	ARAPZoneAccess_Value_ZoneFilterExclusive: "Zone-Filter-Exclusive",
+}
+
+func (a ARAPZoneAccess) String() string {
+	if str, ok := ARAPZoneAccess_Strings[a]; ok {
+		return str
+	}
+	return "ARAPZoneAccess(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// ARAPZoneAccess_Get returns the first ARAP-Zone-Access of p, or ErrNoAttribute.
+func (p *Packet) ARAPZoneAccess_Get() (value ARAPZoneAccess, err error) {
+	value, ok, err := p.ARAPZoneAccess_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPZoneAccess_Gets returns every ARAP-Zone-Access of p.
+func (p *Packet) ARAPZoneAccess_Gets() (values []ARAPZoneAccess, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPZoneAccess_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value ARAPZoneAccess
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: ARAP-Zone-Access: %w", err)
+			return
+		}
+		value = ARAPZoneAccess(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPZoneAccess_Lookup returns the first ARAP-Zone-Access of p. ok is false if there is none.
+func (p *Packet) ARAPZoneAccess_Lookup() (value ARAPZoneAccess, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPZoneAccess_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Zone-Access: %w", err)
+		return
+	}
+	value = ARAPZoneAccess(i)
+	return
+}
+
+// ARAPZoneAccess_Set replaces every ARAP-Zone-Access of p with value.
+func (p *Packet) ARAPZoneAccess_Set(value ARAPZoneAccess) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(ARAPZoneAccess_Type, a)
+	return
+}
+
+// ARAPZoneAccess_Add appends value as a ARAP-Zone-Access to p.
+func (p *Packet) ARAPZoneAccess_Add(value ARAPZoneAccess) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(ARAPZoneAccess_Type, a)
+	return
+}
+
+// ARAPZoneAccess_Del removes every ARAP-Zone-Access from p.
+func (p *Packet) ARAPZoneAccess_Del() {
+	p.Attributes.Del(ARAPZoneAccess_Type)
+}
+
+// ARAPSecurity_Get returns the first ARAP-Security of p, or ErrNoAttribute.
+func (p *Packet) ARAPSecurity_Get() (value uint32, err error) {
+	value, ok, err := p.ARAPSecurity_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPSecurity_Gets returns every ARAP-Security of p.
+func (p *Packet) ARAPSecurity_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPSecurity_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: ARAP-Security: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPSecurity_Lookup returns the first ARAP-Security of p. ok is false if there is none.
+func (p *Packet) ARAPSecurity_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPSecurity_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Security: %w", err)
+		return
+	}
+	return
+}
+
+// ARAPSecurity_Set replaces every ARAP-Security of p with value.
+func (p *Packet) ARAPSecurity_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(ARAPSecurity_Type, a)
+	return
+}
+
+// ARAPSecurity_Add appends value as a ARAP-Security to p.
+func (p *Packet) ARAPSecurity_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(ARAPSecurity_Type, a)
+	return
+}
+
+// ARAPSecurity_Del removes every ARAP-Security from p.
+func (p *Packet) ARAPSecurity_Del() {
+	p.Attributes.Del(ARAPSecurity_Type)
+}
+
+// ARAPSecurityData_Get returns the first ARAP-Security-Data of p, or ErrNoAttribute.
+func (p *Packet) ARAPSecurityData_Get() (value string, err error) {
+	value, ok, err := p.ARAPSecurityData_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPSecurityData_Gets returns every ARAP-Security-Data of p.
+func (p *Packet) ARAPSecurityData_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPSecurityData_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPSecurityData_Lookup returns the first ARAP-Security-Data of p. ok is false if there is none.
+func (p *Packet) ARAPSecurityData_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPSecurityData_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// ARAPSecurityData_Set replaces every ARAP-Security-Data of p with value.
+func (p *Packet) ARAPSecurityData_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Security-Data: %w", err)
+		return
+	}
+	p.Attributes.Set(ARAPSecurityData_Type, a)
+	return
+}
+
+// ARAPSecurityData_Add appends value as a ARAP-Security-Data to p.
+func (p *Packet) ARAPSecurityData_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Security-Data: %w", err)
+		return
+	}
+	p.Attributes.Add(ARAPSecurityData_Type, a)
+	return
+}
+
+// ARAPSecurityData_Del removes every ARAP-Security-Data from p.
+func (p *Packet) ARAPSecurityData_Del() {
+	p.Attributes.Del(ARAPSecurityData_Type)
+}
+
+// PasswordRetry_Get returns the first Password-Retry of p, or ErrNoAttribute.
+func (p *Packet) PasswordRetry_Get() (value uint32, err error) {
+	value, ok, err := p.PasswordRetry_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// PasswordRetry_Gets returns every Password-Retry of p.
+func (p *Packet) PasswordRetry_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != PasswordRetry_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Password-Retry: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// PasswordRetry_Lookup returns the first Password-Retry of p. ok is false if there is none.
+func (p *Packet) PasswordRetry_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(PasswordRetry_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Password-Retry: %w", err)
+		return
+	}
+	return
+}
+
+// PasswordRetry_Set replaces every Password-Retry of p with value.
+func (p *Packet) PasswordRetry_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(PasswordRetry_Type, a)
+	return
+}
+
+// PasswordRetry_Add appends value as a Password-Retry to p.
+func (p *Packet) PasswordRetry_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(PasswordRetry_Type, a)
+	return
+}
+
+// PasswordRetry_Del removes every Password-Retry from p.
+func (p *Packet) PasswordRetry_Del() {
+	p.Attributes.Del(PasswordRetry_Type)
+}
+
+type Prompt uint32
+
+const (
+	Prompt_Value_NoEcho Prompt = 0
+	Prompt_Value_Echo   Prompt = 1
+)
+
+var Prompt_Strings = map[Prompt]string{
+	Prompt_Value_NoEcho: "No-Echo",
+	Prompt_Value_Echo:   "Echo",
+}
+
+func (a Prompt) String() string {
+	if str, ok := Prompt_Strings[a]; ok {
+		return str
+	}
+	return "Prompt(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// Prompt_Get returns the first Prompt of p, or ErrNoAttribute.
+func (p *Packet) Prompt_Get() (value Prompt, err error) {
+	value, ok, err := p.Prompt_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// Prompt_Gets returns every Prompt of p.
+func (p *Packet) Prompt_Gets() (values []Prompt, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != Prompt_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value Prompt
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Prompt: %w", err)
+			return
+		}
+		value = Prompt(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// Prompt_Lookup returns the first Prompt of p. ok is false if there is none.
+func (p *Packet) Prompt_Lookup() (value Prompt, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(Prompt_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Prompt: %w", err)
+		return
+	}
+	value = Prompt(i)
+	return
+}
+
+// Prompt_Set replaces every Prompt of p with value.
+func (p *Packet) Prompt_Set(value Prompt) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(Prompt_Type, a)
+	return
+}
+
+// Prompt_Add appends value as a Prompt to p.
+func (p *Packet) Prompt_Add(value Prompt) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(Prompt_Type, a)
+	return
+}
+
+// Prompt_Del removes every Prompt from p.
+func (p *Packet) Prompt_Del() {
+	p.Attributes.Del(Prompt_Type)
+}
+
+// ConnectInfo_Get returns the first Connect-Info of p, or ErrNoAttribute.
+func (p *Packet) ConnectInfo_Get() (value string, err error) {
+	value, ok, err := p.ConnectInfo_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ConnectInfo_Gets returns every Connect-Info of p.
+func (p *Packet) ConnectInfo_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ConnectInfo_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ConnectInfo_Lookup returns the first Connect-Info of p. ok is false if there is none.
+func (p *Packet) ConnectInfo_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ConnectInfo_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// ConnectInfo_Set replaces every Connect-Info of p with value.
+func (p *Packet) ConnectInfo_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Connect-Info: %w", err)
+		return
+	}
+	p.Attributes.Set(ConnectInfo_Type, a)
+	return
+}
+
+// ConnectInfo_Add appends value as a Connect-Info to p.
+func (p *Packet) ConnectInfo_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Connect-Info: %w", err)
+		return
+	}
+	p.Attributes.Add(ConnectInfo_Type, a)
+	return
+}
+
+// ConnectInfo_Del removes every Connect-Info from p.
+func (p *Packet) ConnectInfo_Del() {
+	p.Attributes.Del(ConnectInfo_Type)
+}
+
+// ConfigurationToken_Get returns the first Configuration-Token of p, or ErrNoAttribute.
+func (p *Packet) ConfigurationToken_Get() (value string, err error) {
+	value, ok, err := p.ConfigurationToken_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ConfigurationToken_Gets returns every Configuration-Token of p.
+func (p *Packet) ConfigurationToken_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ConfigurationToken_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ConfigurationToken_Lookup returns the first Configuration-Token of p. ok is false if there is none.
+func (p *Packet) ConfigurationToken_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ConfigurationToken_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// ConfigurationToken_Set replaces every Configuration-Token of p with value.
+func (p *Packet) ConfigurationToken_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Configuration-Token: %w", err)
+		return
+	}
+	p.Attributes.Set(ConfigurationToken_Type, a)
+	return
+}
+
+// ConfigurationToken_Add appends value as a Configuration-Token to p.
+func (p *Packet) ConfigurationToken_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Configuration-Token: %w", err)
+		return
+	}
+	p.Attributes.Add(ConfigurationToken_Type, a)
+	return
+}
+
+// ConfigurationToken_Del removes every Configuration-Token from p.
+func (p *Packet) ConfigurationToken_Del() {
+	p.Attributes.Del(ConfigurationToken_Type)
+}
+
+// ARAPChallengeResponse_Get returns the first ARAP-Challenge-Response of p, or ErrNoAttribute.
+func (p *Packet) ARAPChallengeResponse_Get() (value []byte, err error) {
+	value, ok, err := p.ARAPChallengeResponse_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ARAPChallengeResponse_Gets returns every ARAP-Challenge-Response of p.
+func (p *Packet) ARAPChallengeResponse_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ARAPChallengeResponse_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ARAPChallengeResponse_Lookup returns the first ARAP-Challenge-Response of p. ok is false if there is none.
+func (p *Packet) ARAPChallengeResponse_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ARAPChallengeResponse_Type)
+	if !ok {
+		return
+	}
+	value = Bytes(a)
+	return
+}
+
+// ARAPChallengeResponse_Set replaces every ARAP-Challenge-Response of p with value.
+func (p *Packet) ARAPChallengeResponse_Set(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Challenge-Response: %w", err)
+		return
+	}
+	p.Attributes.Set(ARAPChallengeResponse_Type, a)
+	return
+}
+
+// ARAPChallengeResponse_Add appends value as a ARAP-Challenge-Response to p.
+func (p *Packet) ARAPChallengeResponse_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: ARAP-Challenge-Response: %w", err)
+		return
+	}
+	p.Attributes.Add(ARAPChallengeResponse_Type, a)
+	return
+}
+
+// ARAPChallengeResponse_Del removes every ARAP-Challenge-Response from p.
+func (p *Packet) ARAPChallengeResponse_Del() {
+	p.Attributes.Del(ARAPChallengeResponse_Type)
+}
+
+// AcctInterimInterval_Get returns the first Acct-Interim-Interval of p, or ErrNoAttribute.
+func (p *Packet) AcctInterimInterval_Get() (value uint32, err error) {
+	value, ok, err := p.AcctInterimInterval_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// AcctInterimInterval_Gets returns every Acct-Interim-Interval of p.
+func (p *Packet) AcctInterimInterval_Gets() (values []uint32, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != AcctInterimInterval_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Acct-Interim-Interval: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// AcctInterimInterval_Lookup returns the first Acct-Interim-Interval of p. ok is false if there is none.
+func (p *Packet) AcctInterimInterval_Lookup() (value uint32, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(AcctInterimInterval_Type)
+	if !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Acct-Interim-Interval: %w", err)
+		return
+	}
+	return
+}
+
+// AcctInterimInterval_Set replaces every Acct-Interim-Interval of p with value.
+func (p *Packet) AcctInterimInterval_Set(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Set(AcctInterimInterval_Type, a)
+	return
+}
+
+// AcctInterimInterval_Add appends value as a Acct-Interim-Interval to p.
+func (p *Packet) AcctInterimInterval_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	p.Attributes.Add(AcctInterimInterval_Type, a)
+	return
+}
+
+// AcctInterimInterval_Del removes every Acct-Interim-Interval from p.
+func (p *Packet) AcctInterimInterval_Del() {
+	p.Attributes.Del(AcctInterimInterval_Type)
+}
+
+// NASPortID_Get returns the first NAS-Port-Id of p, or ErrNoAttribute.
+func (p *Packet) NASPortID_Get() (value string, err error) {
+	value, ok, err := p.NASPortID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// NASPortID_Gets returns every NAS-Port-Id of p.
+func (p *Packet) NASPortID_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASPortID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// NASPortID_Lookup returns the first NAS-Port-Id of p. ok is false if there is none.
+func (p *Packet) NASPortID_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASPortID_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// NASPortID_Set replaces every NAS-Port-Id of p with value.
+func (p *Packet) NASPortID_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-Port-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(NASPortID_Type, a)
+	return
+}
+
+// NASPortID_Add appends value as a NAS-Port-Id to p.
+func (p *Packet) NASPortID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-Port-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(NASPortID_Type, a)
+	return
+}
+
+// NASPortID_Del removes every NAS-Port-Id from p.
+func (p *Packet) NASPortID_Del() {
+	p.Attributes.Del(NASPortID_Type)
+}
+
+// FramedPool_Get returns the first Framed-Pool of p, or ErrNoAttribute.
+func (p *Packet) FramedPool_Get() (value string, err error) {
+	value, ok, err := p.FramedPool_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedPool_Gets returns every Framed-Pool of p.
+func (p *Packet) FramedPool_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedPool_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedPool_Lookup returns the first Framed-Pool of p. ok is false if there is none.
+func (p *Packet) FramedPool_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedPool_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// FramedPool_Set replaces every Framed-Pool of p with value.
+func (p *Packet) FramedPool_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Pool: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedPool_Type, a)
+	return
+}
+
+// FramedPool_Add appends value as a Framed-Pool to p.
+func (p *Packet) FramedPool_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Pool: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedPool_Type, a)
+	return
+}
+
+// FramedPool_Del removes every Framed-Pool from p.
+func (p *Packet) FramedPool_Del() {
+	p.Attributes.Del(FramedPool_Type)
+}
+
+// NASIPv6Address_Get returns the first NAS-IPv6-Address of p, or ErrNoAttribute.
+func (p *Packet) NASIPv6Address_Get() (value net.IP, err error) {
+	value, ok, err := p.NASIPv6Address_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// NASIPv6Address_Gets returns every NAS-IPv6-Address of p.
+func (p *Packet) NASIPv6Address_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != NASIPv6Address_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPv6Addr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: NAS-IPv6-Address: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// NASIPv6Address_Lookup returns the first NAS-IPv6-Address of p. ok is false if there is none.
+func (p *Packet) NASIPv6Address_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(NASIPv6Address_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Addr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-IPv6-Address: %w", err)
+		return
+	}
+	return
+}
+
+// NASIPv6Address_Set replaces every NAS-IPv6-Address of p with value.
+func (p *Packet) NASIPv6Address_Set(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Set(NASIPv6Address_Type, a)
+	return
+}
+
+// NASIPv6Address_Add appends value as a NAS-IPv6-Address to p.
+func (p *Packet) NASIPv6Address_Add(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: NAS-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Add(NASIPv6Address_Type, a)
+	return
+}
+
+// NASIPv6Address_Del removes every NAS-IPv6-Address from p.
+func (p *Packet) NASIPv6Address_Del() {
+	p.Attributes.Del(NASIPv6Address_Type)
+}
+
+// FramedInterfaceID_Get returns the first Framed-Interface-Id of p, or ErrNoAttribute.
+func (p *Packet) FramedInterfaceID_Get() (value []byte, err error) {
+	value, ok, err := p.FramedInterfaceID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedInterfaceID_Gets returns every Framed-Interface-Id of p.
+func (p *Packet) FramedInterfaceID_Gets() (values [][]byte, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedInterfaceID_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value []byte
+		value, err = IfID(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-Interface-Id: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedInterfaceID_Lookup returns the first Framed-Interface-Id of p. ok is false if there is none.
+func (p *Packet) FramedInterfaceID_Lookup() (value []byte, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedInterfaceID_Type)
+	if !ok {
+		return
+	}
+	value, err = IfID(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Interface-Id: %w", err)
+		return
+	}
+	return
+}
+
+// FramedInterfaceID_Set replaces every Framed-Interface-Id of p with value.
+func (p *Packet) FramedInterfaceID_Set(value []byte) (err error) {
+	a, err := NewIfID(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Interface-Id: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedInterfaceID_Type, a)
+	return
+}
+
+// FramedInterfaceID_Add appends value as a Framed-Interface-Id to p.
+func (p *Packet) FramedInterfaceID_Add(value []byte) (err error) {
+	a, err := NewIfID(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-Interface-Id: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedInterfaceID_Type, a)
+	return
+}
+
+// FramedInterfaceID_Del removes every Framed-Interface-Id from p.
+func (p *Packet) FramedInterfaceID_Del() {
+	p.Attributes.Del(FramedInterfaceID_Type)
+}
+
+// FramedIPv6Prefix_Get returns the first Framed-IPv6-Prefix of p, or ErrNoAttribute.
+func (p *Packet) FramedIPv6Prefix_Get() (value *net.IPNet, err error) {
+	value, ok, err := p.FramedIPv6Prefix_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedIPv6Prefix_Gets returns every Framed-IPv6-Prefix of p.
+func (p *Packet) FramedIPv6Prefix_Gets() (values []*net.IPNet, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedIPv6Prefix_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value *net.IPNet
+		value, err = IPv6Prefix(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-IPv6-Prefix: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPv6Prefix_Lookup returns the first Framed-IPv6-Prefix of p. ok is false if there is none.
+func (p *Packet) FramedIPv6Prefix_Lookup() (value *net.IPNet, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPv6Prefix_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Prefix(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Prefix: %w", err)
+		return
+	}
+	return
+}
+
+// FramedIPv6Prefix_Set replaces every Framed-IPv6-Prefix of p with value.
+func (p *Packet) FramedIPv6Prefix_Set(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Prefix: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPv6Prefix_Type, a)
+	return
+}
+
+// FramedIPv6Prefix_Add appends value as a Framed-IPv6-Prefix to p.
+func (p *Packet) FramedIPv6Prefix_Add(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Prefix: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPv6Prefix_Type, a)
+	return
+}
+
+// FramedIPv6Prefix_Del removes every Framed-IPv6-Prefix from p.
+func (p *Packet) FramedIPv6Prefix_Del() {
+	p.Attributes.Del(FramedIPv6Prefix_Type)
+}
+
+// LoginIPv6Host_Get returns the first Login-IPv6-Host of p, or ErrNoAttribute.
+func (p *Packet) LoginIPv6Host_Get() (value net.IP, err error) {
+	value, ok, err := p.LoginIPv6Host_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// LoginIPv6Host_Gets returns every Login-IPv6-Host of p.
+func (p *Packet) LoginIPv6Host_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != LoginIPv6Host_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPv6Addr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Login-IPv6-Host: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// LoginIPv6Host_Lookup returns the first Login-IPv6-Host of p. ok is false if there is none.
+func (p *Packet) LoginIPv6Host_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(LoginIPv6Host_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Addr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IPv6-Host: %w", err)
+		return
+	}
+	return
+}
+
+// LoginIPv6Host_Set replaces every Login-IPv6-Host of p with value.
+func (p *Packet) LoginIPv6Host_Set(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IPv6-Host: %w", err)
+		return
+	}
+	p.Attributes.Set(LoginIPv6Host_Type, a)
+	return
+}
+
+// LoginIPv6Host_Add appends value as a Login-IPv6-Host to p.
+func (p *Packet) LoginIPv6Host_Add(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Login-IPv6-Host: %w", err)
+		return
+	}
+	p.Attributes.Add(LoginIPv6Host_Type, a)
+	return
+}
+
+// LoginIPv6Host_Del removes every Login-IPv6-Host from p.
+func (p *Packet) LoginIPv6Host_Del() {
+	p.Attributes.Del(LoginIPv6Host_Type)
+}
+
+// FramedIPv6Route_Get returns the first Framed-IPv6-Route of p, or ErrNoAttribute.
+func (p *Packet) FramedIPv6Route_Get() (value string, err error) {
+	value, ok, err := p.FramedIPv6Route_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedIPv6Route_Gets returns every Framed-IPv6-Route of p.
+func (p *Packet) FramedIPv6Route_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedIPv6Route_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPv6Route_Lookup returns the first Framed-IPv6-Route of p. ok is false if there is none.
+func (p *Packet) FramedIPv6Route_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPv6Route_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// FramedIPv6Route_Set replaces every Framed-IPv6-Route of p with value.
+func (p *Packet) FramedIPv6Route_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Route: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPv6Route_Type, a)
+	return
+}
+
+// FramedIPv6Route_Add appends value as a Framed-IPv6-Route to p.
+func (p *Packet) FramedIPv6Route_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Route: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPv6Route_Type, a)
+	return
+}
+
+// FramedIPv6Route_Del removes every Framed-IPv6-Route from p.
+func (p *Packet) FramedIPv6Route_Del() {
+	p.Attributes.Del(FramedIPv6Route_Type)
+}
+
+// FramedIPv6Pool_Get returns the first Framed-IPv6-Pool of p, or ErrNoAttribute.
+func (p *Packet) FramedIPv6Pool_Get() (value string, err error) {
+	value, ok, err := p.FramedIPv6Pool_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedIPv6Pool_Gets returns every Framed-IPv6-Pool of p.
+func (p *Packet) FramedIPv6Pool_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedIPv6Pool_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPv6Pool_Lookup returns the first Framed-IPv6-Pool of p. ok is false if there is none.
+func (p *Packet) FramedIPv6Pool_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPv6Pool_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// FramedIPv6Pool_Set replaces every Framed-IPv6-Pool of p with value.
+func (p *Packet) FramedIPv6Pool_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Pool: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPv6Pool_Type, a)
+	return
+}
+
+// FramedIPv6Pool_Add appends value as a Framed-IPv6-Pool to p.
+func (p *Packet) FramedIPv6Pool_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Pool: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPv6Pool_Type, a)
+	return
+}
+
+// FramedIPv6Pool_Del removes every Framed-IPv6-Pool from p.
+func (p *Packet) FramedIPv6Pool_Del() {
+	p.Attributes.Del(FramedIPv6Pool_Type)
+}
+
+// DelegatedIPv6Prefix_Get returns the first Delegated-IPv6-Prefix of p, or ErrNoAttribute.
+func (p *Packet) DelegatedIPv6Prefix_Get() (value *net.IPNet, err error) {
+	value, ok, err := p.DelegatedIPv6Prefix_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// DelegatedIPv6Prefix_Gets returns every Delegated-IPv6-Prefix of p.
+func (p *Packet) DelegatedIPv6Prefix_Gets() (values []*net.IPNet, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != DelegatedIPv6Prefix_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value *net.IPNet
+		value, err = IPv6Prefix(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Delegated-IPv6-Prefix: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// DelegatedIPv6Prefix_Lookup returns the first Delegated-IPv6-Prefix of p. ok is false if there is none.
+func (p *Packet) DelegatedIPv6Prefix_Lookup() (value *net.IPNet, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(DelegatedIPv6Prefix_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Prefix(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Delegated-IPv6-Prefix: %w", err)
+		return
+	}
+	return
+}
+
+// DelegatedIPv6Prefix_Set replaces every Delegated-IPv6-Prefix of p with value.
+func (p *Packet) DelegatedIPv6Prefix_Set(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Delegated-IPv6-Prefix: %w", err)
+		return
+	}
+	p.Attributes.Set(DelegatedIPv6Prefix_Type, a)
+	return
+}
+
+// DelegatedIPv6Prefix_Add appends value as a Delegated-IPv6-Prefix to p.
+func (p *Packet) DelegatedIPv6Prefix_Add(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Delegated-IPv6-Prefix: %w", err)
+		return
+	}
+	p.Attributes.Add(DelegatedIPv6Prefix_Type, a)
+	return
+}
+
+// DelegatedIPv6Prefix_Del removes every Delegated-IPv6-Prefix from p.
+func (p *Packet) DelegatedIPv6Prefix_Del() {
+	p.Attributes.Del(DelegatedIPv6Prefix_Type)
+}
+
+type ErrorCause uint32
+
+const (
+	ErrorCause_Value_ResidualContextRemoved              ErrorCause = 201
+	ErrorCause_Value_InvalidEAPPacket                    ErrorCause = 202
+	ErrorCause_Value_UnsupportedAttribute                ErrorCause = 401
+	ErrorCause_Value_MissingAttribute                    ErrorCause = 402
+	ErrorCause_Value_NASIdentificationMismatch           ErrorCause = 403
+	ErrorCause_Value_InvalidRequest                      ErrorCause = 404
+	ErrorCause_Value_UnsupportedService                  ErrorCause = 405
+	ErrorCause_Value_UnsupportedExtension                ErrorCause = 406
+	ErrorCause_Value_InvalidAttributeValue               ErrorCause = 407
+	ErrorCause_Value_AdministrativelyProhibited          ErrorCause = 501
+	ErrorCause_Value_RequestNotRoutable                  ErrorCause = 502
+	ErrorCause_Value_SessionContextNotFound              ErrorCause = 503
+	ErrorCause_Value_SessionContextNotRemovable          ErrorCause = 504
+	ErrorCause_Value_OtherProxyProcessingError           ErrorCause = 505
+	ErrorCause_Value_ResourcesUnavailable                ErrorCause = 506
+	ErrorCause_Value_RequestInitiated                    ErrorCause = 507
+	ErrorCause_Value_MultipleSessionSelectionUnsupported ErrorCause = 508
+)
+
+var ErrorCause_Strings = map[ErrorCause]string{
+	ErrorCause_Value_ResidualContextRemoved:              "Residual-Context-Removed",
+	ErrorCause_Value_InvalidEAPPacket:                    "Invalid-EAP-Packet",
+	ErrorCause_Value_UnsupportedAttribute:                "Unsupported-Attribute",
+	ErrorCause_Value_MissingAttribute:                    "Missing-Attribute",
+	ErrorCause_Value_NASIdentificationMismatch:           "NAS-Identification-Mismatch",
+	ErrorCause_Value_InvalidRequest:                      "Invalid-Request",
+	ErrorCause_Value_UnsupportedService:                  "Unsupported-Service",
+	ErrorCause_Value_UnsupportedExtension:                "Unsupported-Extension",
+	ErrorCause_Value_InvalidAttributeValue:               "Invalid-Attribute-Value",
+	ErrorCause_Value_AdministrativelyProhibited:          "Administratively-Prohibited",
+	ErrorCause_Value_RequestNotRoutable:                  "Request-Not-Routable",
+	ErrorCause_Value_SessionContextNotFound:              "Session-Context-Not-Found",
+	ErrorCause_Value_SessionContextNotRemovable:          "Session-Context-Not-Removable",
+	ErrorCause_Value_OtherProxyProcessingError:           "Other-Proxy-Processing-Error",
+	ErrorCause_Value_ResourcesUnavailable:                "Resources-Unavailable",
+	ErrorCause_Value_RequestInitiated:                    "Request-Initiated",
+	ErrorCause_Value_MultipleSessionSelectionUnsupported: "Multiple-Session-Selection-Unsupported",
+}
+
+func (a ErrorCause) String() string {
+	if str, ok := ErrorCause_Strings[a]; ok {
+		return str
+	}
+	return "ErrorCause(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// ErrorCause_Get returns the first Error-Cause of p, or ErrNoAttribute.
+func (p *Packet) ErrorCause_Get() (value ErrorCause, err error) {
+	value, ok, err := p.ErrorCause_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ErrorCause_Gets returns every Error-Cause of p.
+func (p *Packet) ErrorCause_Gets() (values []ErrorCause, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != ErrorCause_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value ErrorCause
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Error-Cause: %w", err)
+			return
+		}
+		value = ErrorCause(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// ErrorCause_Lookup returns the first Error-Cause of p. ok is false if there is none.
+func (p *Packet) ErrorCause_Lookup() (value ErrorCause, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(ErrorCause_Type)
+	if !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Error-Cause: %w", err)
+		return
+	}
+	value = ErrorCause(i)
+	return
+}
+
+// ErrorCause_Set replaces every Error-Cause of p with value.
+func (p *Packet) ErrorCause_Set(value ErrorCause) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Set(ErrorCause_Type, a)
+	return
+}
+
+// ErrorCause_Add appends value as a Error-Cause to p.
+func (p *Packet) ErrorCause_Add(value ErrorCause) (err error) {
+	a := NewInteger(uint32(value))
+	p.Attributes.Add(ErrorCause_Type, a)
+	return
+}
+
+// ErrorCause_Del removes every Error-Cause from p.
+func (p *Packet) ErrorCause_Del() {
+	p.Attributes.Del(ErrorCause_Type)
+}
+
+// FramedIPv6Address_Get returns the first Framed-IPv6-Address of p, or ErrNoAttribute.
+func (p *Packet) FramedIPv6Address_Get() (value net.IP, err error) {
+	value, ok, err := p.FramedIPv6Address_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FramedIPv6Address_Gets returns every Framed-IPv6-Address of p.
+func (p *Packet) FramedIPv6Address_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != FramedIPv6Address_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPv6Addr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Framed-IPv6-Address: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// FramedIPv6Address_Lookup returns the first Framed-IPv6-Address of p. ok is false if there is none.
+func (p *Packet) FramedIPv6Address_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(FramedIPv6Address_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Addr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Address: %w", err)
+		return
+	}
+	return
+}
+
+// FramedIPv6Address_Set replaces every Framed-IPv6-Address of p with value.
+func (p *Packet) FramedIPv6Address_Set(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Set(FramedIPv6Address_Type, a)
+	return
+}
+
+// FramedIPv6Address_Add appends value as a Framed-IPv6-Address to p.
+func (p *Packet) FramedIPv6Address_Add(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Framed-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Add(FramedIPv6Address_Type, a)
+	return
+}
+
+// FramedIPv6Address_Del removes every Framed-IPv6-Address from p.
+func (p *Packet) FramedIPv6Address_Del() {
+	p.Attributes.Del(FramedIPv6Address_Type)
+}
+
+// DNSServerIPv6Address_Get returns the first DNS-Server-IPv6-Address of p, or ErrNoAttribute.
+func (p *Packet) DNSServerIPv6Address_Get() (value net.IP, err error) {
+	value, ok, err := p.DNSServerIPv6Address_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// DNSServerIPv6Address_Gets returns every DNS-Server-IPv6-Address of p.
+func (p *Packet) DNSServerIPv6Address_Gets() (values []net.IP, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != DNSServerIPv6Address_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value net.IP
+		value, err = IPv6Addr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: DNS-Server-IPv6-Address: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// DNSServerIPv6Address_Lookup returns the first DNS-Server-IPv6-Address of p. ok is false if there is none.
+func (p *Packet) DNSServerIPv6Address_Lookup() (value net.IP, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(DNSServerIPv6Address_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Addr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: DNS-Server-IPv6-Address: %w", err)
+		return
+	}
+	return
+}
+
+// DNSServerIPv6Address_Set replaces every DNS-Server-IPv6-Address of p with value.
+func (p *Packet) DNSServerIPv6Address_Set(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: DNS-Server-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Set(DNSServerIPv6Address_Type, a)
+	return
+}
+
+// DNSServerIPv6Address_Add appends value as a DNS-Server-IPv6-Address to p.
+func (p *Packet) DNSServerIPv6Address_Add(value net.IP) (err error) {
+	a, err := NewIPv6Addr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: DNS-Server-IPv6-Address: %w", err)
+		return
+	}
+	p.Attributes.Add(DNSServerIPv6Address_Type, a)
+	return
+}
+
+// DNSServerIPv6Address_Del removes every DNS-Server-IPv6-Address from p.
+func (p *Packet) DNSServerIPv6Address_Del() {
+	p.Attributes.Del(DNSServerIPv6Address_Type)
+}
+
+// RouteIPv6Information_Get returns the first Route-IPv6-Information of p, or ErrNoAttribute.
+func (p *Packet) RouteIPv6Information_Get() (value *net.IPNet, err error) {
+	value, ok, err := p.RouteIPv6Information_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// RouteIPv6Information_Gets returns every Route-IPv6-Information of p.
+func (p *Packet) RouteIPv6Information_Gets() (values []*net.IPNet, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != RouteIPv6Information_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value *net.IPNet
+		value, err = IPv6Prefix(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Route-IPv6-Information: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// RouteIPv6Information_Lookup returns the first Route-IPv6-Information of p. ok is false if there is none.
+func (p *Packet) RouteIPv6Information_Lookup() (value *net.IPNet, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(RouteIPv6Information_Type)
+	if !ok {
+		return
+	}
+	value, err = IPv6Prefix(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Route-IPv6-Information: %w", err)
+		return
+	}
+	return
+}
+
+// RouteIPv6Information_Set replaces every Route-IPv6-Information of p with value.
+func (p *Packet) RouteIPv6Information_Set(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Route-IPv6-Information: %w", err)
+		return
+	}
+	p.Attributes.Set(RouteIPv6Information_Type, a)
+	return
+}
+
+// RouteIPv6Information_Add appends value as a Route-IPv6-Information to p.
+func (p *Packet) RouteIPv6Information_Add(value *net.IPNet) (err error) {
+	a, err := NewIPv6Prefix(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Route-IPv6-Information: %w", err)
+		return
+	}
+	p.Attributes.Add(RouteIPv6Information_Type, a)
+	return
+}
+
+// RouteIPv6Information_Del removes every Route-IPv6-Information from p.
+func (p *Packet) RouteIPv6Information_Del() {
+	p.Attributes.Del(RouteIPv6Information_Type)
+}
+
+// DelegatedIPv6PrefixPool_Get returns the first Delegated-IPv6-Prefix-Pool of p, or ErrNoAttribute.
+func (p *Packet) DelegatedIPv6PrefixPool_Get() (value string, err error) {
+	value, ok, err := p.DelegatedIPv6PrefixPool_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// DelegatedIPv6PrefixPool_Gets returns every Delegated-IPv6-Prefix-Pool of p.
+func (p *Packet) DelegatedIPv6PrefixPool_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != DelegatedIPv6PrefixPool_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// DelegatedIPv6PrefixPool_Lookup returns the first Delegated-IPv6-Prefix-Pool of p. ok is false if there is none.
+func (p *Packet) DelegatedIPv6PrefixPool_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(DelegatedIPv6PrefixPool_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// DelegatedIPv6PrefixPool_Set replaces every Delegated-IPv6-Prefix-Pool of p with value.
+func (p *Packet) DelegatedIPv6PrefixPool_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Delegated-IPv6-Prefix-Pool: %w", err)
+		return
+	}
+	p.Attributes.Set(DelegatedIPv6PrefixPool_Type, a)
+	return
+}
+
+// DelegatedIPv6PrefixPool_Add appends value as a Delegated-IPv6-Prefix-Pool to p.
+func (p *Packet) DelegatedIPv6PrefixPool_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Delegated-IPv6-Prefix-Pool: %w", err)
+		return
+	}
+	p.Attributes.Add(DelegatedIPv6PrefixPool_Type, a)
+	return
+}
+
+// DelegatedIPv6PrefixPool_Del removes every Delegated-IPv6-Prefix-Pool from p.
+func (p *Packet) DelegatedIPv6PrefixPool_Del() {
+	p.Attributes.Del(DelegatedIPv6PrefixPool_Type)
+}
+
+// StatefulIPv6AddressPool_Get returns the first Stateful-IPv6-Address-Pool of p, or ErrNoAttribute.
+func (p *Packet) StatefulIPv6AddressPool_Get() (value string, err error) {
+	value, ok, err := p.StatefulIPv6AddressPool_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// StatefulIPv6AddressPool_Gets returns every Stateful-IPv6-Address-Pool of p.
+func (p *Packet) StatefulIPv6AddressPool_Gets() (values []string, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != StatefulIPv6AddressPool_Type {
+			continue
+		}
+		a := avp.Attribute
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// StatefulIPv6AddressPool_Lookup returns the first Stateful-IPv6-Address-Pool of p. ok is false if there is none.
+func (p *Packet) StatefulIPv6AddressPool_Lookup() (value string, ok bool, err error) {
+	a, ok := p.Attributes.Lookup(StatefulIPv6AddressPool_Type)
+	if !ok {
+		return
+	}
+	value = String(a)
+	return
+}
+
+// StatefulIPv6AddressPool_Set replaces every Stateful-IPv6-Address-Pool of p with value.
+func (p *Packet) StatefulIPv6AddressPool_Set(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Stateful-IPv6-Address-Pool: %w", err)
+		return
+	}
+	p.Attributes.Set(StatefulIPv6AddressPool_Type, a)
+	return
+}
+
+// StatefulIPv6AddressPool_Add appends value as a Stateful-IPv6-Address-Pool to p.
+func (p *Packet) StatefulIPv6AddressPool_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Stateful-IPv6-Address-Pool: %w", err)
+		return
+	}
+	p.Attributes.Add(StatefulIPv6AddressPool_Type, a)
+	return
+}
+
+// StatefulIPv6AddressPool_Del removes every Stateful-IPv6-Address-Pool from p.
+func (p *Packet) StatefulIPv6AddressPool_Del() {
+	p.Attributes.Del(StatefulIPv6AddressPool_Type)
+}
+
+type FragStatus uint32
+
+const (
+	FragStatus_Value_Reserved               FragStatus = 0
+	FragStatus_Value_FragmentationSupported FragStatus = 1
+	FragStatus_Value_MoreDataPending        FragStatus = 2
+	FragStatus_Value_MoreDataRequest        FragStatus = 3
+)
+
+var FragStatus_Strings = map[FragStatus]string{
+	FragStatus_Value_Reserved:               "Reserved",
+	FragStatus_Value_FragmentationSupported: "Fragmentation-Supported",
+	FragStatus_Value_MoreDataPending:        "More-Data-Pending",
+	FragStatus_Value_MoreDataRequest:        "More-Data-Request",
+}
+
+func (a FragStatus) String() string {
+	if str, ok := FragStatus_Strings[a]; ok {
+		return str
+	}
+	return "FragStatus(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// FragStatus_Get returns the first Frag-Status of p, or ErrNoAttribute.
+func (p *Packet) FragStatus_Get() (value FragStatus, err error) {
+	value, ok, err := p.FragStatus_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// FragStatus_Gets returns every Frag-Status of p.
+func (p *Packet) FragStatus_Gets() (values []FragStatus, err error) {
+	attrs, err := p.Extended_Gets(ExtendedAttribute1_Type, FragStatus_ExtendedType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value FragStatus
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Frag-Status: %w", err)
+			return
+		}
+		value = FragStatus(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// FragStatus_Lookup returns the first Frag-Status of p. ok is false if there is none.
+func (p *Packet) FragStatus_Lookup() (value FragStatus, ok bool, err error) {
+	a, ok, err := p.Extended_Lookup(ExtendedAttribute1_Type, FragStatus_ExtendedType)
+	if err != nil || !ok {
+		return
+	}
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Frag-Status: %w", err)
+		return
+	}
+	value = FragStatus(i)
+	return
+}
+
+// FragStatus_Set replaces every Frag-Status of p with value.
+func (p *Packet) FragStatus_Set(value FragStatus) (err error) {
+	p.FragStatus_Del()
+	return p.FragStatus_Add(value)
+}
+
+// FragStatus_Add appends value as a Frag-Status to p.
+func (p *Packet) FragStatus_Add(value FragStatus) (err error) {
+	a := NewInteger(uint32(value))
+	return p.Extended_Add(ExtendedAttribute1_Type, FragStatus_ExtendedType, a)
+}
+
+// FragStatus_Del removes every Frag-Status from p.
+func (p *Packet) FragStatus_Del() {
+	p.Extended_Del(ExtendedAttribute1_Type, FragStatus_ExtendedType)
+}
+
+// ProxyStateLength_Get returns the first Proxy-State-Length of p, or ErrNoAttribute.
+func (p *Packet) ProxyStateLength_Get() (value uint32, err error) {
+	value, ok, err := p.ProxyStateLength_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ProxyStateLength_Gets returns every Proxy-State-Length of p.
+func (p *Packet) ProxyStateLength_Gets() (values []uint32, err error) {
+	attrs, err := p.Extended_Gets(ExtendedAttribute1_Type, ProxyStateLength_ExtendedType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Proxy-State-Length: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// ProxyStateLength_Lookup returns the first Proxy-State-Length of p. ok is false if there is none.
+func (p *Packet) ProxyStateLength_Lookup() (value uint32, ok bool, err error) {
+	a, ok, err := p.Extended_Lookup(ExtendedAttribute1_Type, ProxyStateLength_ExtendedType)
+	if err != nil || !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Proxy-State-Length: %w", err)
+		return
+	}
+	return
+}
+
+// ProxyStateLength_Set replaces every Proxy-State-Length of p with value.
+func (p *Packet) ProxyStateLength_Set(value uint32) (err error) {
+	p.ProxyStateLength_Del()
+	return p.ProxyStateLength_Add(value)
+}
+
+// ProxyStateLength_Add appends value as a Proxy-State-Length to p.
+func (p *Packet) ProxyStateLength_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.Extended_Add(ExtendedAttribute1_Type, ProxyStateLength_ExtendedType, a)
+}
+
+// ProxyStateLength_Del removes every Proxy-State-Length from p.
+func (p *Packet) ProxyStateLength_Del() {
+	p.Extended_Del(ExtendedAttribute1_Type, ProxyStateLength_ExtendedType)
+}
+
+// ResponseLength_Get returns the first Response-Length of p, or ErrNoAttribute.
+func (p *Packet) ResponseLength_Get() (value uint32, err error) {
+	value, ok, err := p.ResponseLength_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ResponseLength_Gets returns every Response-Length of p.
+func (p *Packet) ResponseLength_Gets() (values []uint32, err error) {
+	attrs, err := p.Extended_Gets(ExtendedAttribute1_Type, ResponseLength_ExtendedType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Response-Length: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// ResponseLength_Lookup returns the first Response-Length of p. ok is false if there is none.
+func (p *Packet) ResponseLength_Lookup() (value uint32, ok bool, err error) {
+	a, ok, err := p.Extended_Lookup(ExtendedAttribute1_Type, ResponseLength_ExtendedType)
+	if err != nil || !ok {
+		return
+	}
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Response-Length: %w", err)
+		return
+	}
+	return
+}
+
+// ResponseLength_Set replaces every Response-Length of p with value.
+func (p *Packet) ResponseLength_Set(value uint32) (err error) {
+	p.ResponseLength_Del()
+	return p.ResponseLength_Add(value)
+}
+
+// ResponseLength_Add appends value as a Response-Length to p.
+func (p *Packet) ResponseLength_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.Extended_Add(ExtendedAttribute1_Type, ResponseLength_ExtendedType, a)
+}
+
+// ResponseLength_Del removes every Response-Length from p.
+func (p *Packet) ResponseLength_Del() {
+	p.Extended_Del(ExtendedAttribute1_Type, ResponseLength_ExtendedType)
+}
+
+// ArubaUserRole_Get returns the first Aruba-User-Role of p, or ErrNoAttribute.
+func (p *Packet) ArubaUserRole_Get() (value string, err error) {
+	value, ok, err := p.ArubaUserRole_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaUserRole_Gets returns every Aruba-User-Role of p.
+func (p *Packet) ArubaUserRole_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaUserRole_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaUserRole_Lookup returns the first Aruba-User-Role of p. ok is false if there is none.
+func (p *Packet) ArubaUserRole_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaUserRole_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaUserRole_Set replaces every Aruba-User-Role of p with value.
+func (p *Packet) ArubaUserRole_Set(value string) (err error) {
+	p.ArubaUserRole_Del()
+	return p.ArubaUserRole_Add(value)
+}
+
+// ArubaUserRole_Add appends value as a Aruba-User-Role to p.
+func (p *Packet) ArubaUserRole_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-User-Role: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaUserRole_VendorType, a)
+}
+
+// ArubaUserRole_Del removes every Aruba-User-Role from p.
+func (p *Packet) ArubaUserRole_Del() {
+	p.VSA_Del(VendorAruba, ArubaUserRole_VendorType)
+}
+
+// ArubaUserVlan_Get returns the first Aruba-User-Vlan of p, or ErrNoAttribute.
+func (p *Packet) ArubaUserVlan_Get() (value uint32, err error) {
+	value, ok, err := p.ArubaUserVlan_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaUserVlan_Gets returns every Aruba-User-Vlan of p.
+func (p *Packet) ArubaUserVlan_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaUserVlan_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Aruba-User-Vlan: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaUserVlan_Lookup returns the first Aruba-User-Vlan of p. ok is false if there is none.
+func (p *Packet) ArubaUserVlan_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaUserVlan_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-User-Vlan: %w", err)
+		return
+	}
+	return
+}
+
+// ArubaUserVlan_Set replaces every Aruba-User-Vlan of p with value.
+func (p *Packet) ArubaUserVlan_Set(value uint32) (err error) {
+	p.ArubaUserVlan_Del()
+	return p.ArubaUserVlan_Add(value)
+}
+
+// ArubaUserVlan_Add appends value as a Aruba-User-Vlan to p.
+func (p *Packet) ArubaUserVlan_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorAruba, ArubaUserVlan_VendorType, a)
+}
+
+// ArubaUserVlan_Del removes every Aruba-User-Vlan from p.
+func (p *Packet) ArubaUserVlan_Del() {
+	p.VSA_Del(VendorAruba, ArubaUserVlan_VendorType)
+}
+
+// ArubaPrivAdminUser_Get returns the first Aruba-Priv-Admin-User of p, or ErrNoAttribute.
+func (p *Packet) ArubaPrivAdminUser_Get() (value uint32, err error) {
+	value, ok, err := p.ArubaPrivAdminUser_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaPrivAdminUser_Gets returns every Aruba-Priv-Admin-User of p.
+func (p *Packet) ArubaPrivAdminUser_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaPrivAdminUser_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Aruba-Priv-Admin-User: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaPrivAdminUser_Lookup returns the first Aruba-Priv-Admin-User of p. ok is false if there is none.
+func (p *Packet) ArubaPrivAdminUser_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaPrivAdminUser_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Priv-Admin-User: %w", err)
+		return
+	}
+	return
+}
+
+// ArubaPrivAdminUser_Set replaces every Aruba-Priv-Admin-User of p with value.
+func (p *Packet) ArubaPrivAdminUser_Set(value uint32) (err error) {
+	p.ArubaPrivAdminUser_Del()
+	return p.ArubaPrivAdminUser_Add(value)
+}
+
+// ArubaPrivAdminUser_Add appends value as a Aruba-Priv-Admin-User to p.
+func (p *Packet) ArubaPrivAdminUser_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorAruba, ArubaPrivAdminUser_VendorType, a)
+}
+
+// ArubaPrivAdminUser_Del removes every Aruba-Priv-Admin-User from p.
+func (p *Packet) ArubaPrivAdminUser_Del() {
+	p.VSA_Del(VendorAruba, ArubaPrivAdminUser_VendorType)
+}
+
+// ArubaAdminRole_Get returns the first Aruba-Admin-Role of p, or ErrNoAttribute.
+func (p *Packet) ArubaAdminRole_Get() (value string, err error) {
+	value, ok, err := p.ArubaAdminRole_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaAdminRole_Gets returns every Aruba-Admin-Role of p.
+func (p *Packet) ArubaAdminRole_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaAdminRole_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaAdminRole_Lookup returns the first Aruba-Admin-Role of p. ok is false if there is none.
+func (p *Packet) ArubaAdminRole_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaAdminRole_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaAdminRole_Set replaces every Aruba-Admin-Role of p with value.
+func (p *Packet) ArubaAdminRole_Set(value string) (err error) {
+	p.ArubaAdminRole_Del()
+	return p.ArubaAdminRole_Add(value)
+}
+
+// ArubaAdminRole_Add appends value as a Aruba-Admin-Role to p.
+func (p *Packet) ArubaAdminRole_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Admin-Role: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaAdminRole_VendorType, a)
+}
+
+// ArubaAdminRole_Del removes every Aruba-Admin-Role from p.
+func (p *Packet) ArubaAdminRole_Del() {
+	p.VSA_Del(VendorAruba, ArubaAdminRole_VendorType)
+}
+
+// ArubaEssidName_Get returns the first Aruba-Essid-Name of p, or ErrNoAttribute.
+func (p *Packet) ArubaEssidName_Get() (value string, err error) {
+	value, ok, err := p.ArubaEssidName_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaEssidName_Gets returns every Aruba-Essid-Name of p.
+func (p *Packet) ArubaEssidName_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaEssidName_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaEssidName_Lookup returns the first Aruba-Essid-Name of p. ok is false if there is none.
+func (p *Packet) ArubaEssidName_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaEssidName_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaEssidName_Set replaces every Aruba-Essid-Name of p with value.
+func (p *Packet) ArubaEssidName_Set(value string) (err error) {
+	p.ArubaEssidName_Del()
+	return p.ArubaEssidName_Add(value)
+}
+
+// ArubaEssidName_Add appends value as a Aruba-Essid-Name to p.
+func (p *Packet) ArubaEssidName_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Essid-Name: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaEssidName_VendorType, a)
+}
+
+// ArubaEssidName_Del removes every Aruba-Essid-Name from p.
+func (p *Packet) ArubaEssidName_Del() {
+	p.VSA_Del(VendorAruba, ArubaEssidName_VendorType)
+}
+
+// ArubaLocationID_Get returns the first Aruba-Location-Id of p, or ErrNoAttribute.
+func (p *Packet) ArubaLocationID_Get() (value string, err error) {
+	value, ok, err := p.ArubaLocationID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaLocationID_Gets returns every Aruba-Location-Id of p.
+func (p *Packet) ArubaLocationID_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaLocationID_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaLocationID_Lookup returns the first Aruba-Location-Id of p. ok is false if there is none.
+func (p *Packet) ArubaLocationID_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaLocationID_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaLocationID_Set replaces every Aruba-Location-Id of p with value.
+func (p *Packet) ArubaLocationID_Set(value string) (err error) {
+	p.ArubaLocationID_Del()
+	return p.ArubaLocationID_Add(value)
+}
+
+// ArubaLocationID_Add appends value as a Aruba-Location-Id to p.
+func (p *Packet) ArubaLocationID_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Location-Id: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaLocationID_VendorType, a)
+}
+
+// ArubaLocationID_Del removes every Aruba-Location-Id from p.
+func (p *Packet) ArubaLocationID_Del() {
+	p.VSA_Del(VendorAruba, ArubaLocationID_VendorType)
+}
+
+// ArubaPortIdentifier_Get returns the first Aruba-Port-Identifier of p, or ErrNoAttribute.
+func (p *Packet) ArubaPortIdentifier_Get() (value string, err error) {
+	value, ok, err := p.ArubaPortIdentifier_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaPortIdentifier_Gets returns every Aruba-Port-Identifier of p.
+func (p *Packet) ArubaPortIdentifier_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaPortIdentifier_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaPortIdentifier_Lookup returns the first Aruba-Port-Identifier of p. ok is false if there is none.
+func (p *Packet) ArubaPortIdentifier_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaPortIdentifier_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaPortIdentifier_Set replaces every Aruba-Port-Identifier of p with value.
+func (p *Packet) ArubaPortIdentifier_Set(value string) (err error) {
+	p.ArubaPortIdentifier_Del()
+	return p.ArubaPortIdentifier_Add(value)
+}
+
+// ArubaPortIdentifier_Add appends value as a Aruba-Port-Identifier to p.
+func (p *Packet) ArubaPortIdentifier_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Port-Identifier: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaPortIdentifier_VendorType, a)
+}
+
+// ArubaPortIdentifier_Del removes every Aruba-Port-Identifier from p.
+func (p *Packet) ArubaPortIdentifier_Del() {
+	p.VSA_Del(VendorAruba, ArubaPortIdentifier_VendorType)
+}
+
+// ArubaMMSUserTemplate_Get returns the first Aruba-MMS-User-Template of p, or ErrNoAttribute.
+func (p *Packet) ArubaMMSUserTemplate_Get() (value string, err error) {
+	value, ok, err := p.ArubaMMSUserTemplate_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaMMSUserTemplate_Gets returns every Aruba-MMS-User-Template of p.
+func (p *Packet) ArubaMMSUserTemplate_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaMMSUserTemplate_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaMMSUserTemplate_Lookup returns the first Aruba-MMS-User-Template of p. ok is false if there is none.
+func (p *Packet) ArubaMMSUserTemplate_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaMMSUserTemplate_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaMMSUserTemplate_Set replaces every Aruba-MMS-User-Template of p with value.
+func (p *Packet) ArubaMMSUserTemplate_Set(value string) (err error) {
+	p.ArubaMMSUserTemplate_Del()
+	return p.ArubaMMSUserTemplate_Add(value)
+}
+
+// ArubaMMSUserTemplate_Add appends value as a Aruba-MMS-User-Template to p.
+func (p *Packet) ArubaMMSUserTemplate_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-MMS-User-Template: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaMMSUserTemplate_VendorType, a)
+}
+
+// ArubaMMSUserTemplate_Del removes every Aruba-MMS-User-Template from p.
+func (p *Packet) ArubaMMSUserTemplate_Del() {
+	p.VSA_Del(VendorAruba, ArubaMMSUserTemplate_VendorType)
+}
+
+// ArubaNamedUserVlan_Get returns the first Aruba-Named-User-Vlan of p, or ErrNoAttribute.
+func (p *Packet) ArubaNamedUserVlan_Get() (value string, err error) {
+	value, ok, err := p.ArubaNamedUserVlan_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaNamedUserVlan_Gets returns every Aruba-Named-User-Vlan of p.
+func (p *Packet) ArubaNamedUserVlan_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaNamedUserVlan_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaNamedUserVlan_Lookup returns the first Aruba-Named-User-Vlan of p. ok is false if there is none.
+func (p *Packet) ArubaNamedUserVlan_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaNamedUserVlan_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaNamedUserVlan_Set replaces every Aruba-Named-User-Vlan of p with value.
+func (p *Packet) ArubaNamedUserVlan_Set(value string) (err error) {
+	p.ArubaNamedUserVlan_Del()
+	return p.ArubaNamedUserVlan_Add(value)
+}
+
+// ArubaNamedUserVlan_Add appends value as a Aruba-Named-User-Vlan to p.
+func (p *Packet) ArubaNamedUserVlan_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Named-User-Vlan: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaNamedUserVlan_VendorType, a)
+}
+
+// ArubaNamedUserVlan_Del removes every Aruba-Named-User-Vlan from p.
+func (p *Packet) ArubaNamedUserVlan_Del() {
+	p.VSA_Del(VendorAruba, ArubaNamedUserVlan_VendorType)
+}
+
+// ArubaAPGroup_Get returns the first Aruba-AP-Group of p, or ErrNoAttribute.
+func (p *Packet) ArubaAPGroup_Get() (value string, err error) {
+	value, ok, err := p.ArubaAPGroup_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaAPGroup_Gets returns every Aruba-AP-Group of p.
+func (p *Packet) ArubaAPGroup_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaAPGroup_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaAPGroup_Lookup returns the first Aruba-AP-Group of p. ok is false if there is none.
+func (p *Packet) ArubaAPGroup_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaAPGroup_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaAPGroup_Set replaces every Aruba-AP-Group of p with value.
+func (p *Packet) ArubaAPGroup_Set(value string) (err error) {
+	p.ArubaAPGroup_Del()
+	return p.ArubaAPGroup_Add(value)
+}
+
+// ArubaAPGroup_Add appends value as a Aruba-AP-Group to p.
+func (p *Packet) ArubaAPGroup_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-AP-Group: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaAPGroup_VendorType, a)
+}
+
+// ArubaAPGroup_Del removes every Aruba-AP-Group from p.
+func (p *Packet) ArubaAPGroup_Del() {
+	p.VSA_Del(VendorAruba, ArubaAPGroup_VendorType)
+}
+
+// ArubaFramedIPv6Address_Get returns the first Aruba-Framed-IPv6-Address of p, or ErrNoAttribute.
+func (p *Packet) ArubaFramedIPv6Address_Get() (value string, err error) {
+	value, ok, err := p.ArubaFramedIPv6Address_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaFramedIPv6Address_Gets returns every Aruba-Framed-IPv6-Address of p.
+func (p *Packet) ArubaFramedIPv6Address_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaFramedIPv6Address_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaFramedIPv6Address_Lookup returns the first Aruba-Framed-IPv6-Address of p. ok is false if there is none.
+func (p *Packet) ArubaFramedIPv6Address_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaFramedIPv6Address_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaFramedIPv6Address_Set replaces every Aruba-Framed-IPv6-Address of p with value.
+func (p *Packet) ArubaFramedIPv6Address_Set(value string) (err error) {
+	p.ArubaFramedIPv6Address_Del()
+	return p.ArubaFramedIPv6Address_Add(value)
+}
+
+// ArubaFramedIPv6Address_Add appends value as a Aruba-Framed-IPv6-Address to p.
+func (p *Packet) ArubaFramedIPv6Address_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Framed-IPv6-Address: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaFramedIPv6Address_VendorType, a)
+}
+
+// ArubaFramedIPv6Address_Del removes every Aruba-Framed-IPv6-Address from p.
+func (p *Packet) ArubaFramedIPv6Address_Del() {
+	p.VSA_Del(VendorAruba, ArubaFramedIPv6Address_VendorType)
+}
+
+// ArubaDeviceType_Get returns the first Aruba-Device-Type of p, or ErrNoAttribute.
+func (p *Packet) ArubaDeviceType_Get() (value string, err error) {
+	value, ok, err := p.ArubaDeviceType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// ArubaDeviceType_Gets returns every Aruba-Device-Type of p.
+func (p *Packet) ArubaDeviceType_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaDeviceType_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// ArubaDeviceType_Lookup returns the first Aruba-Device-Type of p. ok is false if there is none.
+func (p *Packet) ArubaDeviceType_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorAruba, ArubaDeviceType_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// ArubaDeviceType_Set replaces every Aruba-Device-Type of p with value.
+func (p *Packet) ArubaDeviceType_Set(value string) (err error) {
+	p.ArubaDeviceType_Del()
+	return p.ArubaDeviceType_Add(value)
+}
+
+// ArubaDeviceType_Add appends value as a Aruba-Device-Type to p.
+func (p *Packet) ArubaDeviceType_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Aruba-Device-Type: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorAruba, ArubaDeviceType_VendorType, a)
+}
+
+// ArubaDeviceType_Del removes every Aruba-Device-Type from p.
+func (p *Packet) ArubaDeviceType_Del() {
+	p.VSA_Del(VendorAruba, ArubaDeviceType_VendorType)
+}
+
+// CiscoAVPair_Get returns the first Cisco-AVPair of p, or ErrNoAttribute.
+func (p *Packet) CiscoAVPair_Get() (value string, err error) {
+	value, ok, err := p.CiscoAVPair_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CiscoAVPair_Gets returns every Cisco-AVPair of p.
+func (p *Packet) CiscoAVPair_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoAVPair_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CiscoAVPair_Lookup returns the first Cisco-AVPair of p. ok is false if there is none.
+func (p *Packet) CiscoAVPair_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoAVPair_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// CiscoAVPair_Set replaces every Cisco-AVPair of p with value.
+func (p *Packet) CiscoAVPair_Set(value string) (err error) {
+	p.CiscoAVPair_Del()
+	return p.CiscoAVPair_Add(value)
+}
+
+// CiscoAVPair_Add appends value as a Cisco-AVPair to p.
+func (p *Packet) CiscoAVPair_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Cisco-AVPair: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorCisco, CiscoAVPair_VendorType, a)
+}
+
+// CiscoAVPair_Del removes every Cisco-AVPair from p.
+func (p *Packet) CiscoAVPair_Del() {
+	p.VSA_Del(VendorCisco, CiscoAVPair_VendorType)
+}
+
+// CiscoNASPort_Get returns the first Cisco-NAS-Port of p, or ErrNoAttribute.
+func (p *Packet) CiscoNASPort_Get() (value string, err error) {
+	value, ok, err := p.CiscoNASPort_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CiscoNASPort_Gets returns every Cisco-NAS-Port of p.
+func (p *Packet) CiscoNASPort_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoNASPort_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CiscoNASPort_Lookup returns the first Cisco-NAS-Port of p. ok is false if there is none.
+func (p *Packet) CiscoNASPort_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoNASPort_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// CiscoNASPort_Set replaces every Cisco-NAS-Port of p with value.
+func (p *Packet) CiscoNASPort_Set(value string) (err error) {
+	p.CiscoNASPort_Del()
+	return p.CiscoNASPort_Add(value)
+}
+
+// CiscoNASPort_Add appends value as a Cisco-NAS-Port to p.
+func (p *Packet) CiscoNASPort_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Cisco-NAS-Port: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorCisco, CiscoNASPort_VendorType, a)
+}
+
+// CiscoNASPort_Del removes every Cisco-NAS-Port from p.
+func (p *Packet) CiscoNASPort_Del() {
+	p.VSA_Del(VendorCisco, CiscoNASPort_VendorType)
+}
+
+// CiscoDisconnectCause_Get returns the first Cisco-Disconnect-Cause of p, or ErrNoAttribute.
+func (p *Packet) CiscoDisconnectCause_Get() (value uint32, err error) {
+	value, ok, err := p.CiscoDisconnectCause_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CiscoDisconnectCause_Gets returns every Cisco-Disconnect-Cause of p.
+func (p *Packet) CiscoDisconnectCause_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoDisconnectCause_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Cisco-Disconnect-Cause: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// CiscoDisconnectCause_Lookup returns the first Cisco-Disconnect-Cause of p. ok is false if there is none.
+func (p *Packet) CiscoDisconnectCause_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoDisconnectCause_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Cisco-Disconnect-Cause: %w", err)
+		return
+	}
+	return
+}
+
+// CiscoDisconnectCause_Set replaces every Cisco-Disconnect-Cause of p with value.
+func (p *Packet) CiscoDisconnectCause_Set(value uint32) (err error) {
+	p.CiscoDisconnectCause_Del()
+	return p.CiscoDisconnectCause_Add(value)
+}
+
+// CiscoDisconnectCause_Add appends value as a Cisco-Disconnect-Cause to p.
+func (p *Packet) CiscoDisconnectCause_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorCisco, CiscoDisconnectCause_VendorType, a)
+}
+
+// CiscoDisconnectCause_Del removes every Cisco-Disconnect-Cause from p.
+func (p *Packet) CiscoDisconnectCause_Del() {
+	p.VSA_Del(VendorCisco, CiscoDisconnectCause_VendorType)
+}
+
+// CiscoAccountInfo_Get returns the first Cisco-Account-Info of p, or ErrNoAttribute.
+func (p *Packet) CiscoAccountInfo_Get() (value string, err error) {
+	value, ok, err := p.CiscoAccountInfo_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CiscoAccountInfo_Gets returns every Cisco-Account-Info of p.
+func (p *Packet) CiscoAccountInfo_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoAccountInfo_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CiscoAccountInfo_Lookup returns the first Cisco-Account-Info of p. ok is false if there is none.
+func (p *Packet) CiscoAccountInfo_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoAccountInfo_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// CiscoAccountInfo_Set replaces every Cisco-Account-Info of p with value.
+func (p *Packet) CiscoAccountInfo_Set(value string) (err error) {
+	p.CiscoAccountInfo_Del()
+	return p.CiscoAccountInfo_Add(value)
+}
+
+// CiscoAccountInfo_Add appends value as a Cisco-Account-Info to p.
+func (p *Packet) CiscoAccountInfo_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Cisco-Account-Info: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorCisco, CiscoAccountInfo_VendorType, a)
+}
+
+// CiscoAccountInfo_Del removes every Cisco-Account-Info from p.
+func (p *Packet) CiscoAccountInfo_Del() {
+	p.VSA_Del(VendorCisco, CiscoAccountInfo_VendorType)
+}
+
+// CiscoCommandCode_Get returns the first Cisco-Command-Code of p, or ErrNoAttribute.
+func (p *Packet) CiscoCommandCode_Get() (value string, err error) {
+	value, ok, err := p.CiscoCommandCode_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// CiscoCommandCode_Gets returns every Cisco-Command-Code of p.
+func (p *Packet) CiscoCommandCode_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoCommandCode_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// CiscoCommandCode_Lookup returns the first Cisco-Command-Code of p. ok is false if there is none.
+func (p *Packet) CiscoCommandCode_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorCisco, CiscoCommandCode_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// CiscoCommandCode_Set replaces every Cisco-Command-Code of p with value.
+func (p *Packet) CiscoCommandCode_Set(value string) (err error) {
+	p.CiscoCommandCode_Del()
+	return p.CiscoCommandCode_Add(value)
+}
+
+// CiscoCommandCode_Add appends value as a Cisco-Command-Code to p.
+func (p *Packet) CiscoCommandCode_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Cisco-Command-Code: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorCisco, CiscoCommandCode_VendorType, a)
+}
+
+// CiscoCommandCode_Del removes every Cisco-Command-Code from p.
+func (p *Packet) CiscoCommandCode_Del() {
+	p.VSA_Del(VendorCisco, CiscoCommandCode_VendorType)
+}
+
+// HuaweiInputBurstSize_Get returns the first Huawei-Input-Burst-Size of p, or ErrNoAttribute.
+func (p *Packet) HuaweiInputBurstSize_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiInputBurstSize_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiInputBurstSize_Gets returns every Huawei-Input-Burst-Size of p.
+func (p *Packet) HuaweiInputBurstSize_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputBurstSize_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Input-Burst-Size: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiInputBurstSize_Lookup returns the first Huawei-Input-Burst-Size of p. ok is false if there is none.
+func (p *Packet) HuaweiInputBurstSize_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputBurstSize_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Input-Burst-Size: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiInputBurstSize_Set replaces every Huawei-Input-Burst-Size of p with value.
+func (p *Packet) HuaweiInputBurstSize_Set(value uint32) (err error) {
+	p.HuaweiInputBurstSize_Del()
+	return p.HuaweiInputBurstSize_Add(value)
+}
+
+// HuaweiInputBurstSize_Add appends value as a Huawei-Input-Burst-Size to p.
+func (p *Packet) HuaweiInputBurstSize_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiInputBurstSize_VendorType, a)
+}
+
+// HuaweiInputBurstSize_Del removes every Huawei-Input-Burst-Size from p.
+func (p *Packet) HuaweiInputBurstSize_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiInputBurstSize_VendorType)
+}
+
+// HuaweiInputAverageRate_Get returns the first Huawei-Input-Average-Rate of p, or ErrNoAttribute.
+func (p *Packet) HuaweiInputAverageRate_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiInputAverageRate_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiInputAverageRate_Gets returns every Huawei-Input-Average-Rate of p.
+func (p *Packet) HuaweiInputAverageRate_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputAverageRate_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Input-Average-Rate: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiInputAverageRate_Lookup returns the first Huawei-Input-Average-Rate of p. ok is false if there is none.
+func (p *Packet) HuaweiInputAverageRate_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputAverageRate_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Input-Average-Rate: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiInputAverageRate_Set replaces every Huawei-Input-Average-Rate of p with value.
+func (p *Packet) HuaweiInputAverageRate_Set(value uint32) (err error) {
+	p.HuaweiInputAverageRate_Del()
+	return p.HuaweiInputAverageRate_Add(value)
+}
+
+// HuaweiInputAverageRate_Add appends value as a Huawei-Input-Average-Rate to p.
+func (p *Packet) HuaweiInputAverageRate_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiInputAverageRate_VendorType, a)
+}
+
+// HuaweiInputAverageRate_Del removes every Huawei-Input-Average-Rate from p.
+func (p *Packet) HuaweiInputAverageRate_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiInputAverageRate_VendorType)
+}
+
+// HuaweiInputPeakRate_Get returns the first Huawei-Input-Peak-Rate of p, or ErrNoAttribute.
+func (p *Packet) HuaweiInputPeakRate_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiInputPeakRate_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiInputPeakRate_Gets returns every Huawei-Input-Peak-Rate of p.
+func (p *Packet) HuaweiInputPeakRate_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputPeakRate_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Input-Peak-Rate: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiInputPeakRate_Lookup returns the first Huawei-Input-Peak-Rate of p. ok is false if there is none.
+func (p *Packet) HuaweiInputPeakRate_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiInputPeakRate_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Input-Peak-Rate: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiInputPeakRate_Set replaces every Huawei-Input-Peak-Rate of p with value.
+func (p *Packet) HuaweiInputPeakRate_Set(value uint32) (err error) {
+	p.HuaweiInputPeakRate_Del()
+	return p.HuaweiInputPeakRate_Add(value)
+}
+
+// HuaweiInputPeakRate_Add appends value as a Huawei-Input-Peak-Rate to p.
+func (p *Packet) HuaweiInputPeakRate_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiInputPeakRate_VendorType, a)
+}
+
+// HuaweiInputPeakRate_Del removes every Huawei-Input-Peak-Rate from p.
+func (p *Packet) HuaweiInputPeakRate_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiInputPeakRate_VendorType)
+}
+
+// HuaweiOutputBurstSize_Get returns the first Huawei-Output-Burst-Size of p, or ErrNoAttribute.
+func (p *Packet) HuaweiOutputBurstSize_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiOutputBurstSize_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiOutputBurstSize_Gets returns every Huawei-Output-Burst-Size of p.
+func (p *Packet) HuaweiOutputBurstSize_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputBurstSize_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Output-Burst-Size: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiOutputBurstSize_Lookup returns the first Huawei-Output-Burst-Size of p. ok is false if there is none.
+func (p *Packet) HuaweiOutputBurstSize_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputBurstSize_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Output-Burst-Size: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiOutputBurstSize_Set replaces every Huawei-Output-Burst-Size of p with value.
+func (p *Packet) HuaweiOutputBurstSize_Set(value uint32) (err error) {
+	p.HuaweiOutputBurstSize_Del()
+	return p.HuaweiOutputBurstSize_Add(value)
+}
+
+// HuaweiOutputBurstSize_Add appends value as a Huawei-Output-Burst-Size to p.
+func (p *Packet) HuaweiOutputBurstSize_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiOutputBurstSize_VendorType, a)
+}
+
+// HuaweiOutputBurstSize_Del removes every Huawei-Output-Burst-Size from p.
+func (p *Packet) HuaweiOutputBurstSize_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiOutputBurstSize_VendorType)
+}
+
+// HuaweiOutputAverageRate_Get returns the first Huawei-Output-Average-Rate of p, or ErrNoAttribute.
+func (p *Packet) HuaweiOutputAverageRate_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiOutputAverageRate_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiOutputAverageRate_Gets returns every Huawei-Output-Average-Rate of p.
+func (p *Packet) HuaweiOutputAverageRate_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputAverageRate_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Output-Average-Rate: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiOutputAverageRate_Lookup returns the first Huawei-Output-Average-Rate of p. ok is false if there is none.
+func (p *Packet) HuaweiOutputAverageRate_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputAverageRate_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Output-Average-Rate: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiOutputAverageRate_Set replaces every Huawei-Output-Average-Rate of p with value.
+func (p *Packet) HuaweiOutputAverageRate_Set(value uint32) (err error) {
+	p.HuaweiOutputAverageRate_Del()
+	return p.HuaweiOutputAverageRate_Add(value)
+}
+
+// HuaweiOutputAverageRate_Add appends value as a Huawei-Output-Average-Rate to p.
+func (p *Packet) HuaweiOutputAverageRate_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiOutputAverageRate_VendorType, a)
+}
+
+// HuaweiOutputAverageRate_Del removes every Huawei-Output-Average-Rate from p.
+func (p *Packet) HuaweiOutputAverageRate_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiOutputAverageRate_VendorType)
+}
+
+// HuaweiOutputPeakRate_Get returns the first Huawei-Output-Peak-Rate of p, or ErrNoAttribute.
+func (p *Packet) HuaweiOutputPeakRate_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiOutputPeakRate_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiOutputPeakRate_Gets returns every Huawei-Output-Peak-Rate of p.
+func (p *Packet) HuaweiOutputPeakRate_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputPeakRate_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Output-Peak-Rate: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiOutputPeakRate_Lookup returns the first Huawei-Output-Peak-Rate of p. ok is false if there is none.
+func (p *Packet) HuaweiOutputPeakRate_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiOutputPeakRate_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Output-Peak-Rate: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiOutputPeakRate_Set replaces every Huawei-Output-Peak-Rate of p with value.
+func (p *Packet) HuaweiOutputPeakRate_Set(value uint32) (err error) {
+	p.HuaweiOutputPeakRate_Del()
+	return p.HuaweiOutputPeakRate_Add(value)
+}
+
+// HuaweiOutputPeakRate_Add appends value as a Huawei-Output-Peak-Rate to p.
+func (p *Packet) HuaweiOutputPeakRate_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiOutputPeakRate_VendorType, a)
+}
+
+// HuaweiOutputPeakRate_Del removes every Huawei-Output-Peak-Rate from p.
+func (p *Packet) HuaweiOutputPeakRate_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiOutputPeakRate_VendorType)
+}
+
+// HuaweiConnectID_Get returns the first Huawei-Connect-ID of p, or ErrNoAttribute.
+func (p *Packet) HuaweiConnectID_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiConnectID_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiConnectID_Gets returns every Huawei-Connect-ID of p.
+func (p *Packet) HuaweiConnectID_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiConnectID_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Connect-ID: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiConnectID_Lookup returns the first Huawei-Connect-ID of p. ok is false if there is none.
+func (p *Packet) HuaweiConnectID_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiConnectID_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Connect-ID: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiConnectID_Set replaces every Huawei-Connect-ID of p with value.
+func (p *Packet) HuaweiConnectID_Set(value uint32) (err error) {
+	p.HuaweiConnectID_Del()
+	return p.HuaweiConnectID_Add(value)
+}
+
+// HuaweiConnectID_Add appends value as a Huawei-Connect-ID to p.
+func (p *Packet) HuaweiConnectID_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiConnectID_VendorType, a)
+}
+
+// HuaweiConnectID_Del removes every Huawei-Connect-ID from p.
+func (p *Packet) HuaweiConnectID_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiConnectID_VendorType)
+}
+
+// HuaweiPortalURL_Get returns the first Huawei-Portal-URL of p, or ErrNoAttribute.
+func (p *Packet) HuaweiPortalURL_Get() (value string, err error) {
+	value, ok, err := p.HuaweiPortalURL_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiPortalURL_Gets returns every Huawei-Portal-URL of p.
+func (p *Packet) HuaweiPortalURL_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiPortalURL_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiPortalURL_Lookup returns the first Huawei-Portal-URL of p. ok is false if there is none.
+func (p *Packet) HuaweiPortalURL_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiPortalURL_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// HuaweiPortalURL_Set replaces every Huawei-Portal-URL of p with value.
+func (p *Packet) HuaweiPortalURL_Set(value string) (err error) {
+	p.HuaweiPortalURL_Del()
+	return p.HuaweiPortalURL_Add(value)
+}
+
+// HuaweiPortalURL_Add appends value as a Huawei-Portal-URL to p.
+func (p *Packet) HuaweiPortalURL_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Portal-URL: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorHuawei, HuaweiPortalURL_VendorType, a)
+}
+
+// HuaweiPortalURL_Del removes every Huawei-Portal-URL from p.
+func (p *Packet) HuaweiPortalURL_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiPortalURL_VendorType)
+}
+
+// HuaweiExecPrivilege_Get returns the first Huawei-Exec-Privilege of p, or ErrNoAttribute.
+func (p *Packet) HuaweiExecPrivilege_Get() (value uint32, err error) {
+	value, ok, err := p.HuaweiExecPrivilege_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiExecPrivilege_Gets returns every Huawei-Exec-Privilege of p.
+func (p *Packet) HuaweiExecPrivilege_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiExecPrivilege_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: Huawei-Exec-Privilege: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiExecPrivilege_Lookup returns the first Huawei-Exec-Privilege of p. ok is false if there is none.
+func (p *Packet) HuaweiExecPrivilege_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiExecPrivilege_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Exec-Privilege: %w", err)
+		return
+	}
+	return
+}
+
+// HuaweiExecPrivilege_Set replaces every Huawei-Exec-Privilege of p with value.
+func (p *Packet) HuaweiExecPrivilege_Set(value uint32) (err error) {
+	p.HuaweiExecPrivilege_Del()
+	return p.HuaweiExecPrivilege_Add(value)
+}
+
+// HuaweiExecPrivilege_Add appends value as a Huawei-Exec-Privilege to p.
+func (p *Packet) HuaweiExecPrivilege_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorHuawei, HuaweiExecPrivilege_VendorType, a)
+}
+
+// HuaweiExecPrivilege_Del removes every Huawei-Exec-Privilege from p.
+func (p *Packet) HuaweiExecPrivilege_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiExecPrivilege_VendorType)
+}
+
+// HuaweiDomainName_Get returns the first Huawei-Domain-Name of p, or ErrNoAttribute.
+func (p *Packet) HuaweiDomainName_Get() (value string, err error) {
+	value, ok, err := p.HuaweiDomainName_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// HuaweiDomainName_Gets returns every Huawei-Domain-Name of p.
+func (p *Packet) HuaweiDomainName_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiDomainName_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// HuaweiDomainName_Lookup returns the first Huawei-Domain-Name of p. ok is false if there is none.
+func (p *Packet) HuaweiDomainName_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorHuawei, HuaweiDomainName_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// HuaweiDomainName_Set replaces every Huawei-Domain-Name of p with value.
+func (p *Packet) HuaweiDomainName_Set(value string) (err error) {
+	p.HuaweiDomainName_Del()
+	return p.HuaweiDomainName_Add(value)
+}
+
+// HuaweiDomainName_Add appends value as a Huawei-Domain-Name to p.
+func (p *Packet) HuaweiDomainName_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: Huawei-Domain-Name: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorHuawei, HuaweiDomainName_VendorType, a)
+}
+
+// HuaweiDomainName_Del removes every Huawei-Domain-Name from p.
+func (p *Packet) HuaweiDomainName_Del() {
+	p.VSA_Del(VendorHuawei, HuaweiDomainName_VendorType)
+}
+
+// MSCHAPResponse_Get returns the first MS-CHAP-Response of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPResponse_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPResponse_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPResponse_Gets returns every MS-CHAP-Response of p.
+func (p *Packet) MSCHAPResponse_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPResponse_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPResponse_Lookup returns the first MS-CHAP-Response of p. ok is false if there is none.
+func (p *Packet) MSCHAPResponse_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPResponse_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPResponse_Set replaces every MS-CHAP-Response of p with value.
+func (p *Packet) MSCHAPResponse_Set(value []byte) (err error) {
+	p.MSCHAPResponse_Del()
+	return p.MSCHAPResponse_Add(value)
+}
+
+// MSCHAPResponse_Add appends value as a MS-CHAP-Response to p.
+func (p *Packet) MSCHAPResponse_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-Response: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPResponse_VendorType, a)
+}
+
+// MSCHAPResponse_Del removes every MS-CHAP-Response from p.
+func (p *Packet) MSCHAPResponse_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPResponse_VendorType)
+}
+
+// MSCHAPError_Get returns the first MS-CHAP-Error of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPError_Get() (value string, err error) {
+	value, ok, err := p.MSCHAPError_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPError_Gets returns every MS-CHAP-Error of p.
+func (p *Packet) MSCHAPError_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPError_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPError_Lookup returns the first MS-CHAP-Error of p. ok is false if there is none.
+func (p *Packet) MSCHAPError_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPError_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// MSCHAPError_Set replaces every MS-CHAP-Error of p with value.
+func (p *Packet) MSCHAPError_Set(value string) (err error) {
+	p.MSCHAPError_Del()
+	return p.MSCHAPError_Add(value)
+}
+
+// MSCHAPError_Add appends value as a MS-CHAP-Error to p.
+func (p *Packet) MSCHAPError_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-Error: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPError_VendorType, a)
+}
+
+// MSCHAPError_Del removes every MS-CHAP-Error from p.
+func (p *Packet) MSCHAPError_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPError_VendorType)
+}
+
+// MSCHAPCPW1_Get returns the first MS-CHAP-CPW-1 of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPCPW1_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPCPW1_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPCPW1_Gets returns every MS-CHAP-CPW-1 of p.
+func (p *Packet) MSCHAPCPW1_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPCPW1_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPCPW1_Lookup returns the first MS-CHAP-CPW-1 of p. ok is false if there is none.
+func (p *Packet) MSCHAPCPW1_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPCPW1_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPCPW1_Set replaces every MS-CHAP-CPW-1 of p with value.
+func (p *Packet) MSCHAPCPW1_Set(value []byte) (err error) {
+	p.MSCHAPCPW1_Del()
+	return p.MSCHAPCPW1_Add(value)
+}
+
+// MSCHAPCPW1_Add appends value as a MS-CHAP-CPW-1 to p.
+func (p *Packet) MSCHAPCPW1_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-CPW-1: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPCPW1_VendorType, a)
+}
+
+// MSCHAPCPW1_Del removes every MS-CHAP-CPW-1 from p.
+func (p *Packet) MSCHAPCPW1_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPCPW1_VendorType)
+}
+
+// MSCHAPCPW2_Get returns the first MS-CHAP-CPW-2 of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPCPW2_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPCPW2_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPCPW2_Gets returns every MS-CHAP-CPW-2 of p.
+func (p *Packet) MSCHAPCPW2_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPCPW2_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPCPW2_Lookup returns the first MS-CHAP-CPW-2 of p. ok is false if there is none.
+func (p *Packet) MSCHAPCPW2_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPCPW2_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPCPW2_Set replaces every MS-CHAP-CPW-2 of p with value.
+func (p *Packet) MSCHAPCPW2_Set(value []byte) (err error) {
+	p.MSCHAPCPW2_Del()
+	return p.MSCHAPCPW2_Add(value)
+}
+
+// MSCHAPCPW2_Add appends value as a MS-CHAP-CPW-2 to p.
+func (p *Packet) MSCHAPCPW2_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-CPW-2: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPCPW2_VendorType, a)
+}
+
+// MSCHAPCPW2_Del removes every MS-CHAP-CPW-2 from p.
+func (p *Packet) MSCHAPCPW2_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPCPW2_VendorType)
+}
+
+// MSCHAPLMEncPW_Get returns the first MS-CHAP-LM-Enc-PW of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPLMEncPW_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPLMEncPW_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPLMEncPW_Gets returns every MS-CHAP-LM-Enc-PW of p.
+func (p *Packet) MSCHAPLMEncPW_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPLMEncPW_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPLMEncPW_Lookup returns the first MS-CHAP-LM-Enc-PW of p. ok is false if there is none.
+func (p *Packet) MSCHAPLMEncPW_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPLMEncPW_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPLMEncPW_Set replaces every MS-CHAP-LM-Enc-PW of p with value.
+func (p *Packet) MSCHAPLMEncPW_Set(value []byte) (err error) {
+	p.MSCHAPLMEncPW_Del()
+	return p.MSCHAPLMEncPW_Add(value)
+}
+
+// MSCHAPLMEncPW_Add appends value as a MS-CHAP-LM-Enc-PW to p.
+func (p *Packet) MSCHAPLMEncPW_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-LM-Enc-PW: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPLMEncPW_VendorType, a)
+}
+
+// MSCHAPLMEncPW_Del removes every MS-CHAP-LM-Enc-PW from p.
+func (p *Packet) MSCHAPLMEncPW_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPLMEncPW_VendorType)
+}
+
+// MSCHAPNTEncPW_Get returns the first MS-CHAP-NT-Enc-PW of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPNTEncPW_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPNTEncPW_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPNTEncPW_Gets returns every MS-CHAP-NT-Enc-PW of p.
+func (p *Packet) MSCHAPNTEncPW_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPNTEncPW_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPNTEncPW_Lookup returns the first MS-CHAP-NT-Enc-PW of p. ok is false if there is none.
+func (p *Packet) MSCHAPNTEncPW_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPNTEncPW_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPNTEncPW_Set replaces every MS-CHAP-NT-Enc-PW of p with value.
+func (p *Packet) MSCHAPNTEncPW_Set(value []byte) (err error) {
+	p.MSCHAPNTEncPW_Del()
+	return p.MSCHAPNTEncPW_Add(value)
+}
+
+// MSCHAPNTEncPW_Add appends value as a MS-CHAP-NT-Enc-PW to p.
+func (p *Packet) MSCHAPNTEncPW_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-NT-Enc-PW: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPNTEncPW_VendorType, a)
+}
+
+// MSCHAPNTEncPW_Del removes every MS-CHAP-NT-Enc-PW from p.
+func (p *Packet) MSCHAPNTEncPW_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPNTEncPW_VendorType)
+}
+
+type MSMPPEEncryptionPolicy uint32
+
+const (
+	MSMPPEEncryptionPolicy_Value_EncryptionAllowed  MSMPPEEncryptionPolicy = 1
+	MSMPPEEncryptionPolicy_Value_EncryptionRequired MSMPPEEncryptionPolicy = 2
+)
+
+var MSMPPEEncryptionPolicy_Strings = map[MSMPPEEncryptionPolicy]string{
+	MSMPPEEncryptionPolicy_Value_EncryptionAllowed:  "Encryption-Allowed",
+	MSMPPEEncryptionPolicy_Value_EncryptionRequired: "Encryption-Required",
+}
+
+func (a MSMPPEEncryptionPolicy) String() string {
+	if str, ok := MSMPPEEncryptionPolicy_Strings[a]; ok {
+		return str
+	}
+	return "MSMPPEEncryptionPolicy(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSMPPEEncryptionPolicy_Get returns the first MS-MPPE-Encryption-Policy of p, or ErrNoAttribute.
+func (p *Packet) MSMPPEEncryptionPolicy_Get() (value MSMPPEEncryptionPolicy, err error) {
+	value, ok, err := p.MSMPPEEncryptionPolicy_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSMPPEEncryptionPolicy_Gets returns every MS-MPPE-Encryption-Policy of p.
+func (p *Packet) MSMPPEEncryptionPolicy_Gets() (values []MSMPPEEncryptionPolicy, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSMPPEEncryptionPolicy_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSMPPEEncryptionPolicy
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-MPPE-Encryption-Policy: %w", err)
+			return
+		}
+		value = MSMPPEEncryptionPolicy(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSMPPEEncryptionPolicy_Lookup returns the first MS-MPPE-Encryption-Policy of p. ok is false if there is none.
+func (p *Packet) MSMPPEEncryptionPolicy_Lookup() (value MSMPPEEncryptionPolicy, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSMPPEEncryptionPolicy_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-MPPE-Encryption-Policy: %w", err)
+		return
+	}
+	value = MSMPPEEncryptionPolicy(i)
+	return
+}
+
+// MSMPPEEncryptionPolicy_Set replaces every MS-MPPE-Encryption-Policy of p with value.
+func (p *Packet) MSMPPEEncryptionPolicy_Set(value MSMPPEEncryptionPolicy) (err error) {
+	p.MSMPPEEncryptionPolicy_Del()
+	return p.MSMPPEEncryptionPolicy_Add(value)
+}
+
+// MSMPPEEncryptionPolicy_Add appends value as a MS-MPPE-Encryption-Policy to p.
+func (p *Packet) MSMPPEEncryptionPolicy_Add(value MSMPPEEncryptionPolicy) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSMPPEEncryptionPolicy_VendorType, a)
+}
+
+// MSMPPEEncryptionPolicy_Del removes every MS-MPPE-Encryption-Policy from p.
+func (p *Packet) MSMPPEEncryptionPolicy_Del() {
+	p.VSA_Del(VendorMicrosoft, MSMPPEEncryptionPolicy_VendorType)
+}
+
+type MSMPPEEncryptionTypes uint32
+
+const (
+	MSMPPEEncryptionTypes_Value_RC440bitAllowed      MSMPPEEncryptionTypes = 1
+	MSMPPEEncryptionTypes_Value_RC4128bitAllowed     MSMPPEEncryptionTypes = 2
+	MSMPPEEncryptionTypes_Value_RC440or128BitAllowed MSMPPEEncryptionTypes = 6
+)
+
+var MSMPPEEncryptionTypes_Strings = map[MSMPPEEncryptionTypes]string{
+	MSMPPEEncryptionTypes_Value_RC440bitAllowed:      "RC4-40bit-Allowed",
+	MSMPPEEncryptionTypes_Value_RC4128bitAllowed:     "RC4-128bit-Allowed",
+	MSMPPEEncryptionTypes_Value_RC440or128BitAllowed: "RC4-40or128-bit-Allowed",
+}
+
+func (a MSMPPEEncryptionTypes) String() string {
+	if str, ok := MSMPPEEncryptionTypes_Strings[a]; ok {
+		return str
+	}
+	return "MSMPPEEncryptionTypes(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSMPPEEncryptionTypes_Get returns the first MS-MPPE-Encryption-Types of p, or ErrNoAttribute.
+func (p *Packet) MSMPPEEncryptionTypes_Get() (value MSMPPEEncryptionTypes, err error) {
+	value, ok, err := p.MSMPPEEncryptionTypes_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSMPPEEncryptionTypes_Gets returns every MS-MPPE-Encryption-Types of p.
+func (p *Packet) MSMPPEEncryptionTypes_Gets() (values []MSMPPEEncryptionTypes, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSMPPEEncryptionTypes_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSMPPEEncryptionTypes
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-MPPE-Encryption-Types: %w", err)
+			return
+		}
+		value = MSMPPEEncryptionTypes(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSMPPEEncryptionTypes_Lookup returns the first MS-MPPE-Encryption-Types of p. ok is false if there is none.
+func (p *Packet) MSMPPEEncryptionTypes_Lookup() (value MSMPPEEncryptionTypes, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSMPPEEncryptionTypes_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-MPPE-Encryption-Types: %w", err)
+		return
+	}
+	value = MSMPPEEncryptionTypes(i)
+	return
+}
+
+// MSMPPEEncryptionTypes_Set replaces every MS-MPPE-Encryption-Types of p with value.
+func (p *Packet) MSMPPEEncryptionTypes_Set(value MSMPPEEncryptionTypes) (err error) {
+	p.MSMPPEEncryptionTypes_Del()
+	return p.MSMPPEEncryptionTypes_Add(value)
+}
+
+// MSMPPEEncryptionTypes_Add appends value as a MS-MPPE-Encryption-Types to p.
+func (p *Packet) MSMPPEEncryptionTypes_Add(value MSMPPEEncryptionTypes) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSMPPEEncryptionTypes_VendorType, a)
+}
+
+// MSMPPEEncryptionTypes_Del removes every MS-MPPE-Encryption-Types from p.
+func (p *Packet) MSMPPEEncryptionTypes_Del() {
+	p.VSA_Del(VendorMicrosoft, MSMPPEEncryptionTypes_VendorType)
+}
+
+// MSRASVendor_Get returns the first MS-RAS-Vendor of p, or ErrNoAttribute.
+func (p *Packet) MSRASVendor_Get() (value uint32, err error) {
+	value, ok, err := p.MSRASVendor_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSRASVendor_Gets returns every MS-RAS-Vendor of p.
+func (p *Packet) MSRASVendor_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSRASVendor_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-RAS-Vendor: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSRASVendor_Lookup returns the first MS-RAS-Vendor of p. ok is false if there is none.
+func (p *Packet) MSRASVendor_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSRASVendor_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-RAS-Vendor: %w", err)
+		return
+	}
+	return
+}
+
+// MSRASVendor_Set replaces every MS-RAS-Vendor of p with value.
+func (p *Packet) MSRASVendor_Set(value uint32) (err error) {
+	p.MSRASVendor_Del()
+	return p.MSRASVendor_Add(value)
+}
+
+// MSRASVendor_Add appends value as a MS-RAS-Vendor to p.
+func (p *Packet) MSRASVendor_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorMicrosoft, MSRASVendor_VendorType, a)
+}
+
+// MSRASVendor_Del removes every MS-RAS-Vendor from p.
+func (p *Packet) MSRASVendor_Del() {
+	p.VSA_Del(VendorMicrosoft, MSRASVendor_VendorType)
+}
+
+// MSCHAPDomain_Get returns the first MS-CHAP-Domain of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPDomain_Get() (value string, err error) {
+	value, ok, err := p.MSCHAPDomain_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPDomain_Gets returns every MS-CHAP-Domain of p.
+func (p *Packet) MSCHAPDomain_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPDomain_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPDomain_Lookup returns the first MS-CHAP-Domain of p. ok is false if there is none.
+func (p *Packet) MSCHAPDomain_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPDomain_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// MSCHAPDomain_Set replaces every MS-CHAP-Domain of p with value.
+func (p *Packet) MSCHAPDomain_Set(value string) (err error) {
+	p.MSCHAPDomain_Del()
+	return p.MSCHAPDomain_Add(value)
+}
+
+// MSCHAPDomain_Add appends value as a MS-CHAP-Domain to p.
+func (p *Packet) MSCHAPDomain_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-Domain: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPDomain_VendorType, a)
+}
+
+// MSCHAPDomain_Del removes every MS-CHAP-Domain from p.
+func (p *Packet) MSCHAPDomain_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPDomain_VendorType)
+}
+
+// MSCHAPChallenge_Get returns the first MS-CHAP-Challenge of p, or ErrNoAttribute.
+func (p *Packet) MSCHAPChallenge_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAPChallenge_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAPChallenge_Gets returns every MS-CHAP-Challenge of p.
+func (p *Packet) MSCHAPChallenge_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPChallenge_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAPChallenge_Lookup returns the first MS-CHAP-Challenge of p. ok is false if there is none.
+func (p *Packet) MSCHAPChallenge_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAPChallenge_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAPChallenge_Set replaces every MS-CHAP-Challenge of p with value.
+func (p *Packet) MSCHAPChallenge_Set(value []byte) (err error) {
+	p.MSCHAPChallenge_Del()
+	return p.MSCHAPChallenge_Add(value)
+}
+
+// MSCHAPChallenge_Add appends value as a MS-CHAP-Challenge to p.
+func (p *Packet) MSCHAPChallenge_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP-Challenge: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAPChallenge_VendorType, a)
+}
+
+// MSCHAPChallenge_Del removes every MS-CHAP-Challenge from p.
+func (p *Packet) MSCHAPChallenge_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAPChallenge_VendorType)
+}
+
+type MSBAPUsage uint32
+
+const (
+	MSBAPUsage_Value_NotAllowed MSBAPUsage = 0
+	MSBAPUsage_Value_Allowed    MSBAPUsage = 1
+	MSBAPUsage_Value_Required   MSBAPUsage = 2
+)
+
+var MSBAPUsage_Strings = map[MSBAPUsage]string{
+	MSBAPUsage_Value_NotAllowed: "Not-Allowed",
+	MSBAPUsage_Value_Allowed:    "Allowed",
+	MSBAPUsage_Value_Required:   "Required",
+}
+
+func (a MSBAPUsage) String() string {
+	if str, ok := MSBAPUsage_Strings[a]; ok {
+		return str
+	}
+	return "MSBAPUsage(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSBAPUsage_Get returns the first MS-BAP-Usage of p, or ErrNoAttribute.
+func (p *Packet) MSBAPUsage_Get() (value MSBAPUsage, err error) {
+	value, ok, err := p.MSBAPUsage_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSBAPUsage_Gets returns every MS-BAP-Usage of p.
+func (p *Packet) MSBAPUsage_Gets() (values []MSBAPUsage, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSBAPUsage_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSBAPUsage
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-BAP-Usage: %w", err)
+			return
+		}
+		value = MSBAPUsage(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSBAPUsage_Lookup returns the first MS-BAP-Usage of p. ok is false if there is none.
+func (p *Packet) MSBAPUsage_Lookup() (value MSBAPUsage, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSBAPUsage_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-BAP-Usage: %w", err)
+		return
+	}
+	value = MSBAPUsage(i)
+	return
+}
+
+// MSBAPUsage_Set replaces every MS-BAP-Usage of p with value.
+func (p *Packet) MSBAPUsage_Set(value MSBAPUsage) (err error) {
+	p.MSBAPUsage_Del()
+	return p.MSBAPUsage_Add(value)
+}
+
+// MSBAPUsage_Add appends value as a MS-BAP-Usage to p.
+func (p *Packet) MSBAPUsage_Add(value MSBAPUsage) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSBAPUsage_VendorType, a)
+}
+
+// MSBAPUsage_Del removes every MS-BAP-Usage from p.
+func (p *Packet) MSBAPUsage_Del() {
+	p.VSA_Del(VendorMicrosoft, MSBAPUsage_VendorType)
+}
+
+// MSLinkUtilizationThreshold_Get returns the first MS-Link-Utilization-Threshold of p, or ErrNoAttribute.
+func (p *Packet) MSLinkUtilizationThreshold_Get() (value uint32, err error) {
+	value, ok, err := p.MSLinkUtilizationThreshold_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSLinkUtilizationThreshold_Gets returns every MS-Link-Utilization-Threshold of p.
+func (p *Packet) MSLinkUtilizationThreshold_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSLinkUtilizationThreshold_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Link-Utilization-Threshold: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSLinkUtilizationThreshold_Lookup returns the first MS-Link-Utilization-Threshold of p. ok is false if there is none.
+func (p *Packet) MSLinkUtilizationThreshold_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSLinkUtilizationThreshold_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Link-Utilization-Threshold: %w", err)
+		return
+	}
+	return
+}
+
+// MSLinkUtilizationThreshold_Set replaces every MS-Link-Utilization-Threshold of p with value.
+func (p *Packet) MSLinkUtilizationThreshold_Set(value uint32) (err error) {
+	p.MSLinkUtilizationThreshold_Del()
+	return p.MSLinkUtilizationThreshold_Add(value)
+}
+
+// MSLinkUtilizationThreshold_Add appends value as a MS-Link-Utilization-Threshold to p.
+func (p *Packet) MSLinkUtilizationThreshold_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorMicrosoft, MSLinkUtilizationThreshold_VendorType, a)
+}
+
+// MSLinkUtilizationThreshold_Del removes every MS-Link-Utilization-Threshold from p.
+func (p *Packet) MSLinkUtilizationThreshold_Del() {
+	p.VSA_Del(VendorMicrosoft, MSLinkUtilizationThreshold_VendorType)
+}
+
+// MSLinkDropTimeLimit_Get returns the first MS-Link-Drop-Time-Limit of p, or ErrNoAttribute.
+func (p *Packet) MSLinkDropTimeLimit_Get() (value uint32, err error) {
+	value, ok, err := p.MSLinkDropTimeLimit_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSLinkDropTimeLimit_Gets returns every MS-Link-Drop-Time-Limit of p.
+func (p *Packet) MSLinkDropTimeLimit_Gets() (values []uint32, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSLinkDropTimeLimit_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value uint32
+		value, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Link-Drop-Time-Limit: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSLinkDropTimeLimit_Lookup returns the first MS-Link-Drop-Time-Limit of p. ok is false if there is none.
+func (p *Packet) MSLinkDropTimeLimit_Lookup() (value uint32, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSLinkDropTimeLimit_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Link-Drop-Time-Limit: %w", err)
+		return
+	}
+	return
+}
+
+// MSLinkDropTimeLimit_Set replaces every MS-Link-Drop-Time-Limit of p with value.
+func (p *Packet) MSLinkDropTimeLimit_Set(value uint32) (err error) {
+	p.MSLinkDropTimeLimit_Del()
+	return p.MSLinkDropTimeLimit_Add(value)
+}
+
+// MSLinkDropTimeLimit_Add appends value as a MS-Link-Drop-Time-Limit to p.
+func (p *Packet) MSLinkDropTimeLimit_Add(value uint32) (err error) {
+	a := NewInteger(value)
+	return p.VSA_Add(VendorMicrosoft, MSLinkDropTimeLimit_VendorType, a)
+}
+
+// MSLinkDropTimeLimit_Del removes every MS-Link-Drop-Time-Limit from p.
+func (p *Packet) MSLinkDropTimeLimit_Del() {
+	p.VSA_Del(VendorMicrosoft, MSLinkDropTimeLimit_VendorType)
+}
+
+// MSRASVersion_Get returns the first MS-RAS-Version of p, or ErrNoAttribute.
+func (p *Packet) MSRASVersion_Get() (value string, err error) {
+	value, ok, err := p.MSRASVersion_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSRASVersion_Gets returns every MS-RAS-Version of p.
+func (p *Packet) MSRASVersion_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSRASVersion_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSRASVersion_Lookup returns the first MS-RAS-Version of p. ok is false if there is none.
+func (p *Packet) MSRASVersion_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSRASVersion_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// MSRASVersion_Set replaces every MS-RAS-Version of p with value.
+func (p *Packet) MSRASVersion_Set(value string) (err error) {
+	p.MSRASVersion_Del()
+	return p.MSRASVersion_Add(value)
+}
+
+// MSRASVersion_Add appends value as a MS-RAS-Version to p.
+func (p *Packet) MSRASVersion_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-RAS-Version: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSRASVersion_VendorType, a)
+}
+
+// MSRASVersion_Del removes every MS-RAS-Version from p.
+func (p *Packet) MSRASVersion_Del() {
+	p.VSA_Del(VendorMicrosoft, MSRASVersion_VendorType)
+}
+
+// MSOldARAPPassword_Get returns the first MS-Old-ARAP-Password of p, or ErrNoAttribute.
+func (p *Packet) MSOldARAPPassword_Get() (value []byte, err error) {
+	value, ok, err := p.MSOldARAPPassword_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSOldARAPPassword_Gets returns every MS-Old-ARAP-Password of p.
+func (p *Packet) MSOldARAPPassword_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSOldARAPPassword_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSOldARAPPassword_Lookup returns the first MS-Old-ARAP-Password of p. ok is false if there is none.
+func (p *Packet) MSOldARAPPassword_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSOldARAPPassword_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSOldARAPPassword_Set replaces every MS-Old-ARAP-Password of p with value.
+func (p *Packet) MSOldARAPPassword_Set(value []byte) (err error) {
+	p.MSOldARAPPassword_Del()
+	return p.MSOldARAPPassword_Add(value)
+}
+
+// MSOldARAPPassword_Add appends value as a MS-Old-ARAP-Password to p.
+func (p *Packet) MSOldARAPPassword_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Old-ARAP-Password: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSOldARAPPassword_VendorType, a)
+}
+
+// MSOldARAPPassword_Del removes every MS-Old-ARAP-Password from p.
+func (p *Packet) MSOldARAPPassword_Del() {
+	p.VSA_Del(VendorMicrosoft, MSOldARAPPassword_VendorType)
+}
+
+// MSNewARAPPassword_Get returns the first MS-New-ARAP-Password of p, or ErrNoAttribute.
+func (p *Packet) MSNewARAPPassword_Get() (value []byte, err error) {
+	value, ok, err := p.MSNewARAPPassword_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSNewARAPPassword_Gets returns every MS-New-ARAP-Password of p.
+func (p *Packet) MSNewARAPPassword_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSNewARAPPassword_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSNewARAPPassword_Lookup returns the first MS-New-ARAP-Password of p. ok is false if there is none.
+func (p *Packet) MSNewARAPPassword_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSNewARAPPassword_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSNewARAPPassword_Set replaces every MS-New-ARAP-Password of p with value.
+func (p *Packet) MSNewARAPPassword_Set(value []byte) (err error) {
+	p.MSNewARAPPassword_Del()
+	return p.MSNewARAPPassword_Add(value)
+}
+
+// MSNewARAPPassword_Add appends value as a MS-New-ARAP-Password to p.
+func (p *Packet) MSNewARAPPassword_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-New-ARAP-Password: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSNewARAPPassword_VendorType, a)
+}
+
+// MSNewARAPPassword_Del removes every MS-New-ARAP-Password from p.
+func (p *Packet) MSNewARAPPassword_Del() {
+	p.VSA_Del(VendorMicrosoft, MSNewARAPPassword_VendorType)
+}
+
+type MSARAPPWChangeReason uint32
+
+const (
+	MSARAPPWChangeReason_Value_JustChangePassword          MSARAPPWChangeReason = 1
+	MSARAPPWChangeReason_Value_ExpiredPassword             MSARAPPWChangeReason = 2
+	MSARAPPWChangeReason_Value_AdminRequiresPasswordChange MSARAPPWChangeReason = 3
+	MSARAPPWChangeReason_Value_PasswordTooShort            MSARAPPWChangeReason = 4
+)
+
+var MSARAPPWChangeReason_Strings = map[MSARAPPWChangeReason]string{
+	MSARAPPWChangeReason_Value_JustChangePassword:          "Just-Change-Password",
+	MSARAPPWChangeReason_Value_ExpiredPassword:             "Expired-Password",
+	MSARAPPWChangeReason_Value_AdminRequiresPasswordChange: "Admin-Requires-Password-Change",
+	MSARAPPWChangeReason_Value_PasswordTooShort:            "Password-Too-Short",
+}
+
+func (a MSARAPPWChangeReason) String() string {
+	if str, ok := MSARAPPWChangeReason_Strings[a]; ok {
+		return str
+	}
+	return "MSARAPPWChangeReason(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSARAPPWChangeReason_Get returns the first MS-ARAP-PW-Change-Reason of p, or ErrNoAttribute.
+func (p *Packet) MSARAPPWChangeReason_Get() (value MSARAPPWChangeReason, err error) {
+	value, ok, err := p.MSARAPPWChangeReason_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSARAPPWChangeReason_Gets returns every MS-ARAP-PW-Change-Reason of p.
+func (p *Packet) MSARAPPWChangeReason_Gets() (values []MSARAPPWChangeReason, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSARAPPWChangeReason_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSARAPPWChangeReason
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-ARAP-PW-Change-Reason: %w", err)
+			return
+		}
+		value = MSARAPPWChangeReason(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSARAPPWChangeReason_Lookup returns the first MS-ARAP-PW-Change-Reason of p. ok is false if there is none.
+func (p *Packet) MSARAPPWChangeReason_Lookup() (value MSARAPPWChangeReason, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSARAPPWChangeReason_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-ARAP-PW-Change-Reason: %w", err)
+		return
+	}
+	value = MSARAPPWChangeReason(i)
+	return
+}
+
+// MSARAPPWChangeReason_Set replaces every MS-ARAP-PW-Change-Reason of p with value.
+func (p *Packet) MSARAPPWChangeReason_Set(value MSARAPPWChangeReason) (err error) {
+	p.MSARAPPWChangeReason_Del()
+	return p.MSARAPPWChangeReason_Add(value)
+}
+
+// MSARAPPWChangeReason_Add appends value as a MS-ARAP-PW-Change-Reason to p.
+func (p *Packet) MSARAPPWChangeReason_Add(value MSARAPPWChangeReason) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSARAPPWChangeReason_VendorType, a)
+}
+
+// MSARAPPWChangeReason_Del removes every MS-ARAP-PW-Change-Reason from p.
+func (p *Packet) MSARAPPWChangeReason_Del() {
+	p.VSA_Del(VendorMicrosoft, MSARAPPWChangeReason_VendorType)
+}
+
+// MSFilter_Get returns the first MS-Filter of p, or ErrNoAttribute.
+func (p *Packet) MSFilter_Get() (value []byte, err error) {
+	value, ok, err := p.MSFilter_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSFilter_Gets returns every MS-Filter of p.
+func (p *Packet) MSFilter_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSFilter_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSFilter_Lookup returns the first MS-Filter of p. ok is false if there is none.
+func (p *Packet) MSFilter_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSFilter_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSFilter_Set replaces every MS-Filter of p with value.
+func (p *Packet) MSFilter_Set(value []byte) (err error) {
+	p.MSFilter_Del()
+	return p.MSFilter_Add(value)
+}
+
+// MSFilter_Add appends value as a MS-Filter to p.
+func (p *Packet) MSFilter_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Filter: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSFilter_VendorType, a)
+}
+
+// MSFilter_Del removes every MS-Filter from p.
+func (p *Packet) MSFilter_Del() {
+	p.VSA_Del(VendorMicrosoft, MSFilter_VendorType)
+}
+
+type MSAcctAuthType uint32
+
+const (
+	MSAcctAuthType_Value_PAP     MSAcctAuthType = 1
+	MSAcctAuthType_Value_CHAP    MSAcctAuthType = 2
+	MSAcctAuthType_Value_MSCHAP1 MSAcctAuthType = 3
+	MSAcctAuthType_Value_MSCHAP2 MSAcctAuthType = 4
+	MSAcctAuthType_Value_EAP     MSAcctAuthType = 5
+)
+
+var MSAcctAuthType_Strings = map[MSAcctAuthType]string{
+	MSAcctAuthType_Value_PAP:     "PAP",
+	MSAcctAuthType_Value_CHAP:    "CHAP",
+	MSAcctAuthType_Value_MSCHAP1: "MS-CHAP-1",
+	MSAcctAuthType_Value_MSCHAP2: "MS-CHAP-2",
+	MSAcctAuthType_Value_EAP:     "EAP",
+}
+
+func (a MSAcctAuthType) String() string {
+	if str, ok := MSAcctAuthType_Strings[a]; ok {
+		return str
+	}
+	return "MSAcctAuthType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSAcctAuthType_Get returns the first MS-Acct-Auth-Type of p, or ErrNoAttribute.
+func (p *Packet) MSAcctAuthType_Get() (value MSAcctAuthType, err error) {
+	value, ok, err := p.MSAcctAuthType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSAcctAuthType_Gets returns every MS-Acct-Auth-Type of p.
+func (p *Packet) MSAcctAuthType_Gets() (values []MSAcctAuthType, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSAcctAuthType_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSAcctAuthType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Acct-Auth-Type: %w", err)
+			return
+		}
+		value = MSAcctAuthType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSAcctAuthType_Lookup returns the first MS-Acct-Auth-Type of p. ok is false if there is none.
+func (p *Packet) MSAcctAuthType_Lookup() (value MSAcctAuthType, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSAcctAuthType_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Acct-Auth-Type: %w", err)
+		return
+	}
+	value = MSAcctAuthType(i)
+	return
+}
+
+// MSAcctAuthType_Set replaces every MS-Acct-Auth-Type of p with value.
+func (p *Packet) MSAcctAuthType_Set(value MSAcctAuthType) (err error) {
+	p.MSAcctAuthType_Del()
+	return p.MSAcctAuthType_Add(value)
+}
+
+// MSAcctAuthType_Add appends value as a MS-Acct-Auth-Type to p.
+func (p *Packet) MSAcctAuthType_Add(value MSAcctAuthType) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSAcctAuthType_VendorType, a)
+}
+
+// MSAcctAuthType_Del removes every MS-Acct-Auth-Type from p.
+func (p *Packet) MSAcctAuthType_Del() {
+	p.VSA_Del(VendorMicrosoft, MSAcctAuthType_VendorType)
+}
+
+type MSAcctEAPType uint32
+
+const (
+	MSAcctEAPType_Value_MD5              MSAcctEAPType = 4
+	MSAcctEAPType_Value_OTP              MSAcctEAPType = 5
+	MSAcctEAPType_Value_GenericTokenCard MSAcctEAPType = 6
+	MSAcctEAPType_Value_TLS              MSAcctEAPType = 13
+)
+
+var MSAcctEAPType_Strings = map[MSAcctEAPType]string{
+	MSAcctEAPType_Value_MD5:              "MD5",
+	MSAcctEAPType_Value_OTP:              "OTP",
+	MSAcctEAPType_Value_GenericTokenCard: "Generic-Token-Card",
+	MSAcctEAPType_Value_TLS:              "TLS",
+}
+
+func (a MSAcctEAPType) String() string {
+	if str, ok := MSAcctEAPType_Strings[a]; ok {
+		return str
+	}
+	return "MSAcctEAPType(" + strconv.FormatUint(uint64(a), 10) + ")"
+}
+
+// MSAcctEAPType_Get returns the first MS-Acct-EAP-Type of p, or ErrNoAttribute.
+func (p *Packet) MSAcctEAPType_Get() (value MSAcctEAPType, err error) {
+	value, ok, err := p.MSAcctEAPType_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSAcctEAPType_Gets returns every MS-Acct-EAP-Type of p.
+func (p *Packet) MSAcctEAPType_Gets() (values []MSAcctEAPType, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSAcctEAPType_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value MSAcctEAPType
+		var i uint32
+		i, err = Integer(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Acct-EAP-Type: %w", err)
+			return
+		}
+		value = MSAcctEAPType(i)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSAcctEAPType_Lookup returns the first MS-Acct-EAP-Type of p. ok is false if there is none.
+func (p *Packet) MSAcctEAPType_Lookup() (value MSAcctEAPType, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSAcctEAPType_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	var i uint32
+	i, err = Integer(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Acct-EAP-Type: %w", err)
+		return
+	}
+	value = MSAcctEAPType(i)
+	return
+}
+
+// MSAcctEAPType_Set replaces every MS-Acct-EAP-Type of p with value.
+func (p *Packet) MSAcctEAPType_Set(value MSAcctEAPType) (err error) {
+	p.MSAcctEAPType_Del()
+	return p.MSAcctEAPType_Add(value)
+}
+
+// MSAcctEAPType_Add appends value as a MS-Acct-EAP-Type to p.
+func (p *Packet) MSAcctEAPType_Add(value MSAcctEAPType) (err error) {
+	a := NewInteger(uint32(value))
+	return p.VSA_Add(VendorMicrosoft, MSAcctEAPType_VendorType, a)
+}
+
+// MSAcctEAPType_Del removes every MS-Acct-EAP-Type from p.
+func (p *Packet) MSAcctEAPType_Del() {
+	p.VSA_Del(VendorMicrosoft, MSAcctEAPType_VendorType)
+}
+
+// MSCHAP2Response_Get returns the first MS-CHAP2-Response of p, or ErrNoAttribute.
+func (p *Packet) MSCHAP2Response_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAP2Response_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAP2Response_Gets returns every MS-CHAP2-Response of p.
+func (p *Packet) MSCHAP2Response_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2Response_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAP2Response_Lookup returns the first MS-CHAP2-Response of p. ok is false if there is none.
+func (p *Packet) MSCHAP2Response_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2Response_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAP2Response_Set replaces every MS-CHAP2-Response of p with value.
+func (p *Packet) MSCHAP2Response_Set(value []byte) (err error) {
+	p.MSCHAP2Response_Del()
+	return p.MSCHAP2Response_Add(value)
+}
+
+// MSCHAP2Response_Add appends value as a MS-CHAP2-Response to p.
+func (p *Packet) MSCHAP2Response_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP2-Response: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAP2Response_VendorType, a)
+}
+
+// MSCHAP2Response_Del removes every MS-CHAP2-Response from p.
+func (p *Packet) MSCHAP2Response_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAP2Response_VendorType)
+}
+
+// MSCHAP2Success_Get returns the first MS-CHAP2-Success of p, or ErrNoAttribute.
+func (p *Packet) MSCHAP2Success_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAP2Success_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAP2Success_Gets returns every MS-CHAP2-Success of p.
+func (p *Packet) MSCHAP2Success_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2Success_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAP2Success_Lookup returns the first MS-CHAP2-Success of p. ok is false if there is none.
+func (p *Packet) MSCHAP2Success_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2Success_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAP2Success_Set replaces every MS-CHAP2-Success of p with value.
+func (p *Packet) MSCHAP2Success_Set(value []byte) (err error) {
+	p.MSCHAP2Success_Del()
+	return p.MSCHAP2Success_Add(value)
+}
+
+// MSCHAP2Success_Add appends value as a MS-CHAP2-Success to p.
+func (p *Packet) MSCHAP2Success_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP2-Success: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAP2Success_VendorType, a)
+}
+
+// MSCHAP2Success_Del removes every MS-CHAP2-Success from p.
+func (p *Packet) MSCHAP2Success_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAP2Success_VendorType)
+}
+
+// MSCHAP2CPW_Get returns the first MS-CHAP2-CPW of p, or ErrNoAttribute.
+func (p *Packet) MSCHAP2CPW_Get() (value []byte, err error) {
+	value, ok, err := p.MSCHAP2CPW_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSCHAP2CPW_Gets returns every MS-CHAP2-CPW of p.
+func (p *Packet) MSCHAP2CPW_Gets() (values [][]byte, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2CPW_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value []byte
+		value = Bytes(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// MSCHAP2CPW_Lookup returns the first MS-CHAP2-CPW of p. ok is false if there is none.
+func (p *Packet) MSCHAP2CPW_Lookup() (value []byte, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSCHAP2CPW_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = Bytes(a)
+	return
+}
+
+// MSCHAP2CPW_Set replaces every MS-CHAP2-CPW of p with value.
+func (p *Packet) MSCHAP2CPW_Set(value []byte) (err error) {
+	p.MSCHAP2CPW_Del()
+	return p.MSCHAP2CPW_Add(value)
+}
+
+// MSCHAP2CPW_Add appends value as a MS-CHAP2-CPW to p.
+func (p *Packet) MSCHAP2CPW_Add(value []byte) (err error) {
+	a, err := NewBytes(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-CHAP2-CPW: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSCHAP2CPW_VendorType, a)
+}
+
+// MSCHAP2CPW_Del removes every MS-CHAP2-CPW from p.
+func (p *Packet) MSCHAP2CPW_Del() {
+	p.VSA_Del(VendorMicrosoft, MSCHAP2CPW_VendorType)
+}
+
+// MSPrimaryDNSServer_Get returns the first MS-Primary-DNS-Server of p, or ErrNoAttribute.
+func (p *Packet) MSPrimaryDNSServer_Get() (value net.IP, err error) {
+	value, ok, err := p.MSPrimaryDNSServer_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSPrimaryDNSServer_Gets returns every MS-Primary-DNS-Server of p.
+func (p *Packet) MSPrimaryDNSServer_Gets() (values []net.IP, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSPrimaryDNSServer_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Primary-DNS-Server: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSPrimaryDNSServer_Lookup returns the first MS-Primary-DNS-Server of p. ok is false if there is none.
+func (p *Packet) MSPrimaryDNSServer_Lookup() (value net.IP, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSPrimaryDNSServer_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Primary-DNS-Server: %w", err)
+		return
+	}
+	return
+}
+
+// MSPrimaryDNSServer_Set replaces every MS-Primary-DNS-Server of p with value.
+func (p *Packet) MSPrimaryDNSServer_Set(value net.IP) (err error) {
+	p.MSPrimaryDNSServer_Del()
+	return p.MSPrimaryDNSServer_Add(value)
+}
+
+// MSPrimaryDNSServer_Add appends value as a MS-Primary-DNS-Server to p.
+func (p *Packet) MSPrimaryDNSServer_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Primary-DNS-Server: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSPrimaryDNSServer_VendorType, a)
+}
+
+// MSPrimaryDNSServer_Del removes every MS-Primary-DNS-Server from p.
+func (p *Packet) MSPrimaryDNSServer_Del() {
+	p.VSA_Del(VendorMicrosoft, MSPrimaryDNSServer_VendorType)
+}
+
+// MSSecondaryDNSServer_Get returns the first MS-Secondary-DNS-Server of p, or ErrNoAttribute.
+func (p *Packet) MSSecondaryDNSServer_Get() (value net.IP, err error) {
+	value, ok, err := p.MSSecondaryDNSServer_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSSecondaryDNSServer_Gets returns every MS-Secondary-DNS-Server of p.
+func (p *Packet) MSSecondaryDNSServer_Gets() (values []net.IP, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSSecondaryDNSServer_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Secondary-DNS-Server: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSSecondaryDNSServer_Lookup returns the first MS-Secondary-DNS-Server of p. ok is false if there is none.
+func (p *Packet) MSSecondaryDNSServer_Lookup() (value net.IP, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSSecondaryDNSServer_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Secondary-DNS-Server: %w", err)
+		return
+	}
+	return
+}
+
+// MSSecondaryDNSServer_Set replaces every MS-Secondary-DNS-Server of p with value.
+func (p *Packet) MSSecondaryDNSServer_Set(value net.IP) (err error) {
+	p.MSSecondaryDNSServer_Del()
+	return p.MSSecondaryDNSServer_Add(value)
+}
+
+// MSSecondaryDNSServer_Add appends value as a MS-Secondary-DNS-Server to p.
+func (p *Packet) MSSecondaryDNSServer_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Secondary-DNS-Server: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSSecondaryDNSServer_VendorType, a)
+}
+
+// MSSecondaryDNSServer_Del removes every MS-Secondary-DNS-Server from p.
+func (p *Packet) MSSecondaryDNSServer_Del() {
+	p.VSA_Del(VendorMicrosoft, MSSecondaryDNSServer_VendorType)
+}
+
+// MSPrimaryNBNSServer_Get returns the first MS-Primary-NBNS-Server of p, or ErrNoAttribute.
+func (p *Packet) MSPrimaryNBNSServer_Get() (value net.IP, err error) {
+	value, ok, err := p.MSPrimaryNBNSServer_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSPrimaryNBNSServer_Gets returns every MS-Primary-NBNS-Server of p.
+func (p *Packet) MSPrimaryNBNSServer_Gets() (values []net.IP, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSPrimaryNBNSServer_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Primary-NBNS-Server: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSPrimaryNBNSServer_Lookup returns the first MS-Primary-NBNS-Server of p. ok is false if there is none.
+func (p *Packet) MSPrimaryNBNSServer_Lookup() (value net.IP, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSPrimaryNBNSServer_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Primary-NBNS-Server: %w", err)
+		return
+	}
+	return
+}
+
+// MSPrimaryNBNSServer_Set replaces every MS-Primary-NBNS-Server of p with value.
+func (p *Packet) MSPrimaryNBNSServer_Set(value net.IP) (err error) {
+	p.MSPrimaryNBNSServer_Del()
+	return p.MSPrimaryNBNSServer_Add(value)
+}
+
+// MSPrimaryNBNSServer_Add appends value as a MS-Primary-NBNS-Server to p.
+func (p *Packet) MSPrimaryNBNSServer_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Primary-NBNS-Server: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSPrimaryNBNSServer_VendorType, a)
+}
+
+// MSPrimaryNBNSServer_Del removes every MS-Primary-NBNS-Server from p.
+func (p *Packet) MSPrimaryNBNSServer_Del() {
+	p.VSA_Del(VendorMicrosoft, MSPrimaryNBNSServer_VendorType)
+}
+
+// MSSecondaryNBNSServer_Get returns the first MS-Secondary-NBNS-Server of p, or ErrNoAttribute.
+func (p *Packet) MSSecondaryNBNSServer_Get() (value net.IP, err error) {
+	value, ok, err := p.MSSecondaryNBNSServer_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// MSSecondaryNBNSServer_Gets returns every MS-Secondary-NBNS-Server of p.
+func (p *Packet) MSSecondaryNBNSServer_Gets() (values []net.IP, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSSecondaryNBNSServer_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value net.IP
+		value, err = IPAddr(a)
+		if err != nil {
+			err = fmt.Errorf("radius: MS-Secondary-NBNS-Server: %w", err)
+			return
+		}
+		values = append(values, value)
+	}
+	return
+}
+
+// MSSecondaryNBNSServer_Lookup returns the first MS-Secondary-NBNS-Server of p. ok is false if there is none.
+func (p *Packet) MSSecondaryNBNSServer_Lookup() (value net.IP, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorMicrosoft, MSSecondaryNBNSServer_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value, err = IPAddr(a)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Secondary-NBNS-Server: %w", err)
+		return
+	}
+	return
+}
+
+// MSSecondaryNBNSServer_Set replaces every MS-Secondary-NBNS-Server of p with value.
+func (p *Packet) MSSecondaryNBNSServer_Set(value net.IP) (err error) {
+	p.MSSecondaryNBNSServer_Del()
+	return p.MSSecondaryNBNSServer_Add(value)
+}
+
+// MSSecondaryNBNSServer_Add appends value as a MS-Secondary-NBNS-Server to p.
+func (p *Packet) MSSecondaryNBNSServer_Add(value net.IP) (err error) {
+	a, err := NewIPAddr(value)
+	if err != nil {
+		err = fmt.Errorf("radius: MS-Secondary-NBNS-Server: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorMicrosoft, MSSecondaryNBNSServer_VendorType, a)
+}
+
+// MSSecondaryNBNSServer_Del removes every MS-Secondary-NBNS-Server from p.
+func (p *Packet) MSSecondaryNBNSServer_Del() {
+	p.VSA_Del(VendorMicrosoft, MSSecondaryNBNSServer_VendorType)
+}
+
+// USRLastNumberDialedOut_Get returns the first USR-Last-Number-Dialed-Out of p, or ErrNoAttribute.
+func (p *Packet) USRLastNumberDialedOut_Get() (value string, err error) {
+	value, ok, err := p.USRLastNumberDialedOut_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// USRLastNumberDialedOut_Gets returns every USR-Last-Number-Dialed-Out of p.
+func (p *Packet) USRLastNumberDialedOut_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorUSR, USRLastNumberDialedOut_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// USRLastNumberDialedOut_Lookup returns the first USR-Last-Number-Dialed-Out of p. ok is false if there is none.
+func (p *Packet) USRLastNumberDialedOut_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorUSR, USRLastNumberDialedOut_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// USRLastNumberDialedOut_Set replaces every USR-Last-Number-Dialed-Out of p with value.
+func (p *Packet) USRLastNumberDialedOut_Set(value string) (err error) {
+	p.USRLastNumberDialedOut_Del()
+	return p.USRLastNumberDialedOut_Add(value)
+}
+
+// USRLastNumberDialedOut_Add appends value as a USR-Last-Number-Dialed-Out to p.
+func (p *Packet) USRLastNumberDialedOut_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: USR-Last-Number-Dialed-Out: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorUSR, USRLastNumberDialedOut_VendorType, a)
+}
+
+// USRLastNumberDialedOut_Del removes every USR-Last-Number-Dialed-Out from p.
+func (p *Packet) USRLastNumberDialedOut_Del() {
+	p.VSA_Del(VendorUSR, USRLastNumberDialedOut_VendorType)
+}
+
+// USRLastNumberDialedInDNIS_Get returns the first USR-Last-Number-Dialed-In-DNIS of p, or ErrNoAttribute.
+func (p *Packet) USRLastNumberDialedInDNIS_Get() (value string, err error) {
+	value, ok, err := p.USRLastNumberDialedInDNIS_Lookup()
+	if err == nil && !ok {
+		err = ErrNoAttribute
+	}
+	return
+}
+
+// USRLastNumberDialedInDNIS_Gets returns every USR-Last-Number-Dialed-In-DNIS of p.
+func (p *Packet) USRLastNumberDialedInDNIS_Gets() (values []string, err error) {
+	attrs, err := p.VSA_Gets(VendorUSR, USRLastNumberDialedInDNIS_VendorType)
+	if err != nil {
+		return
+	}
+	for _, a := range attrs {
+		var value string
+		value = String(a)
+		values = append(values, value)
+	}
+	return
+}
+
+// USRLastNumberDialedInDNIS_Lookup returns the first USR-Last-Number-Dialed-In-DNIS of p. ok is false if there is none.
+func (p *Packet) USRLastNumberDialedInDNIS_Lookup() (value string, ok bool, err error) {
+	attrs, err := p.VSA_Gets(VendorUSR, USRLastNumberDialedInDNIS_VendorType)
+	if err != nil || len(attrs) == 0 {
+		return
+	}
+	ok = true
+	a := attrs[0]
+	value = String(a)
+	return
+}
+
+// USRLastNumberDialedInDNIS_Set replaces every USR-Last-Number-Dialed-In-DNIS of p with value.
+func (p *Packet) USRLastNumberDialedInDNIS_Set(value string) (err error) {
+	p.USRLastNumberDialedInDNIS_Del()
+	return p.USRLastNumberDialedInDNIS_Add(value)
+}
+
+// USRLastNumberDialedInDNIS_Add appends value as a USR-Last-Number-Dialed-In-DNIS to p.
+func (p *Packet) USRLastNumberDialedInDNIS_Add(value string) (err error) {
+	a, err := NewString(value)
+	if err != nil {
+		err = fmt.Errorf("radius: USR-Last-Number-Dialed-In-DNIS: %w", err)
+		return
+	}
+	return p.VSA_Add(VendorUSR, USRLastNumberDialedInDNIS_VendorType, a)
+}
+
+// USRLastNumberDialedInDNIS_Del removes every USR-Last-Number-Dialed-In-DNIS from p.
+func (p *Packet) USRLastNumberDialedInDNIS_Del() {
+	p.VSA_Del(VendorUSR, USRLastNumberDialedInDNIS_VendorType)
+}
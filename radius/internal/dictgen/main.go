@@ -0,0 +1,520 @@
+// Command dictgen generates typed attribute accessors for package radius
+// from FreeRADIUS format dictionary files.
+//
+// For every attribute it emits a Name_Type (or Name_VendorType, or
+// Name_ExtendedType for RFC 6929 extended attributes) constant and Name_Get, Name_Gets, Name_Lookup, Name_Set, Name_Add and Name_Del methods
+// on *Packet. Integer attributes with VALUE definitions get an enum type.
+// Encrypted and concat attributes, and those listed with -manual, only get
+// their constant: their accessors are written by hand.
+//
+// Usage:
+//
+//	go run ./internal/dictgen -o generated.go [-manual Name,...] dictionary...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sdir/eapol_test/radius"
+)
+
+func main() {
+	output := flag.String("o", "generated.go", "output file")
+	manual := flag.String("manual", "", "comma separated attributes whose accessors are written by hand")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("dictgen: no dictionary given")
+	}
+
+	d := radius.NewDictionary()
+	for _, file := range flag.Args() {
+		if err := d.ParseFile(file); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	g := &generator{
+		dict:    d,
+		manual:  make(map[string]bool),
+		imports: make(map[string]bool),
+	}
+	for _, name := range strings.Split(*manual, ",") {
+		if name != "" {
+			g.manual[strings.ToLower(name)] = true
+		}
+	}
+	src, err := g.generate(flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	dict    *radius.Dictionary
+	manual  map[string]bool
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func (g *generator) p(format string, args ...interface{}) {
+	fmt.Fprintf(&g.body, format, args...)
+	g.body.WriteByte('\n')
+}
+
+func (g *generator) generate(files []string) ([]byte, error) {
+	g.vendors()
+	g.types()
+	for _, attr := range g.dict.Attributes {
+		if !g.accessible(attr) {
+			continue
+		}
+		g.enum(attr)
+		g.accessors(attr)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by dictgen from %s. DO NOT EDIT.\n\n", strings.Join(files, ", "))
+	fmt.Fprintf(&out, "package radius\n\n")
+	if len(g.imports) > 0 {
+		var imports []string
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&out, "import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		fmt.Fprintf(&out, ")\n\n")
+	}
+	out.Write(g.body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return out.Bytes(), fmt.Errorf("dictgen: %s", err)
+	}
+	return src, nil
+}
+
+func (g *generator) vendors() {
+	if len(g.dict.Vendors) == 0 {
+		return
+	}
+	g.p("// Vendor identifiers.")
+	g.p("const (")
+	for _, v := range g.dict.Vendors {
+		g.p("\tVendor%s uint32 = %d", identifier(v.Name), v.ID)
+	}
+	g.p(")\n")
+}
+
+func (g *generator) types() {
+	g.p("// Attribute types.")
+	g.p("const (")
+	for _, attr := range g.dict.Attributes {
+		if attr.Vendor == 0 && len(attr.OID) == 1 {
+			g.p("\t%s_Type Type = %d", identifier(attr.Name), attr.OID[0])
+		}
+	}
+	g.p(")\n")
+
+	g.p("// Vendor attribute types.")
+	g.p("const (")
+	for _, attr := range g.dict.Attributes {
+		if attr.Vendor != 0 && len(attr.OID) == 1 {
+			g.p("\t%s_VendorType uint32 = %d", identifier(attr.Name), attr.OID[0])
+		}
+	}
+	g.p(")\n")
+
+	g.p("// Extended attribute types.")
+	g.p("const (")
+	for _, attr := range g.dict.Attributes {
+		// The Extended-Vendor-Specific attributes all share type 26 and
+		// are only told apart by their parent.
+		if g.extendedParent(attr) != nil && attr.DataType != radius.DataTypeEVS {
+			g.p("\t%s_ExtendedType byte = %d", identifier(attr.Name), attr.OID[1])
+		}
+	}
+	g.p(")\n")
+}
+
+// extendedParent returns the RFC 6929 extended attribute attr is a direct
+// child of, or nil.
+func (g *generator) extendedParent(attr *radius.DictAttribute) *radius.DictAttribute {
+	if attr.Vendor != 0 || len(attr.OID) != 2 {
+		return nil
+	}
+	parent, ok := g.dict.AttributeByOID(0, attr.OID[0])
+	if !ok || (parent.DataType != radius.DataTypeExtended && parent.DataType != radius.DataTypeLongExtended) {
+		return nil
+	}
+	return parent
+}
+
+// accessible reports whether accessors are generated for attr.
+func (g *generator) accessible(attr *radius.DictAttribute) bool {
+	if (len(attr.OID) != 1 && g.extendedParent(attr) == nil) || attr.Encrypt != 0 || attr.Concat || g.manual[strings.ToLower(attr.Name)] {
+		return false
+	}
+	switch attr.DataType {
+	case radius.DataTypeTLV, radius.DataTypeVSA, radius.DataTypeExtended,
+		radius.DataTypeLongExtended, radius.DataTypeEVS:
+		return false
+	}
+	return true
+}
+
+// scalar describes the Go type of a fixed format data type and the
+// functions of package radius encoding and decoding it.
+type scalar struct {
+	goType   string
+	imp      string
+	encode   string
+	decode   string
+	fallible bool // encode returns an error
+}
+
+var scalars = map[radius.DataType]scalar{
+	radius.DataTypeInteger:    {goType: "uint32", encode: "NewInteger", decode: "Integer"},
+	radius.DataTypeByte:       {goType: "uint8", encode: "NewByte", decode: "Byte"},
+	radius.DataTypeShort:      {goType: "uint16", encode: "NewShort", decode: "Short"},
+	radius.DataTypeInteger64:  {goType: "uint64", encode: "NewInteger64", decode: "Integer64"},
+	radius.DataTypeSigned:     {goType: "int32", encode: "NewSigned", decode: "Signed"},
+	radius.DataTypeDate:       {goType: "time.Time", imp: "time", encode: "NewDate", decode: "Date", fallible: true},
+	radius.DataTypeIPAddr:     {goType: "net.IP", imp: "net", encode: "NewIPAddr", decode: "IPAddr", fallible: true},
+	radius.DataTypeIPv6Addr:   {goType: "net.IP", imp: "net", encode: "NewIPv6Addr", decode: "IPv6Addr", fallible: true},
+	radius.DataTypeComboIP:    {goType: "net.IP", imp: "net", encode: "NewComboIP", decode: "ComboIP", fallible: true},
+	radius.DataTypeIPv6Prefix: {goType: "*net.IPNet", imp: "net", encode: "NewIPv6Prefix", decode: "IPv6Prefix", fallible: true},
+	radius.DataTypeIPv4Prefix: {goType: "*net.IPNet", imp: "net", encode: "NewIPv4Prefix", decode: "IPv4Prefix", fallible: true},
+	radius.DataTypeIfID:       {goType: "[]byte", encode: "NewIfID", decode: "IfID", fallible: true},
+}
+
+func (g *generator) isEnum(attr *radius.DictAttribute) bool {
+	switch attr.DataType {
+	case radius.DataTypeInteger, radius.DataTypeByte, radius.DataTypeShort:
+		return len(attr.Values) > 0
+	}
+	return false
+}
+
+func (g *generator) enum(attr *radius.DictAttribute) {
+	if !g.isEnum(attr) {
+		return
+	}
+	g.imports["strconv"] = true
+	ident := identifier(attr.Name)
+
+	g.p("type %s %s\n", ident, scalars[attr.DataType].goType)
+	g.p("const (")
+	seen := make(map[string]bool)
+	for _, v := range attr.Values {
+		name := identifier(v.Name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		g.p("\t%s_Value_%s %s = %d", ident, name, ident, v.Value)
+	}
+	g.p(")\n")
+
+	g.p("var %s_Strings = map[%s]string{", ident, ident)
+	seenValue := make(map[uint64]bool)
+	for _, v := range attr.Values {
+		if seenValue[v.Value] {
+			continue
+		}
+		seenValue[v.Value] = true
+		g.p("\t%s_Value_%s: %q,", ident, identifier(v.Name), v.Name)
+	}
+	g.p("}\n")
+
+	g.p("func (a %s) String() string {", ident)
+	g.p("\tif str, ok := %s_Strings[a]; ok {", ident)
+	g.p("\t\treturn str")
+	g.p("\t}")
+	g.p("\treturn \"%s(\" + strconv.FormatUint(uint64(a), 10) + \")\"", ident)
+	g.p("}\n")
+}
+
+// codec returns the Go type of attr and the statements converting between
+// it and an Attribute.
+func (g *generator) codec(attr *radius.DictAttribute) (goType string, encode, decode func(name string) string) {
+	wrap := func(name string) string {
+		g.imports["fmt"] = true
+		return fmt.Sprintf("if err != nil {\n\terr = fmt.Errorf(\"radius: %s: %%w\", err)\n\treturn\n}", name)
+	}
+	sc, ok := scalars[attr.DataType]
+	switch {
+	case g.isEnum(attr):
+		goType = identifier(attr.Name)
+		encode = func(string) string { return fmt.Sprintf("a := %s(%s(value))", sc.encode, sc.goType) }
+		decode = func(name string) string {
+			return fmt.Sprintf("var i %s\ni, err = %s(a)\n%s\nvalue = %s(i)", sc.goType, sc.decode, wrap(name), goType)
+		}
+	case ok:
+		if sc.imp != "" {
+			g.imports[sc.imp] = true
+		}
+		goType = sc.goType
+		encode = func(name string) string {
+			if sc.fallible {
+				return fmt.Sprintf("a, err := %s(value)\n%s", sc.encode, wrap(name))
+			}
+			return fmt.Sprintf("a := %s(value)", sc.encode)
+		}
+		decode = func(name string) string { return fmt.Sprintf("value, err = %s(a)\n%s", sc.decode, wrap(name)) }
+	case attr.DataType == radius.DataTypeString:
+		goType = "string"
+		encode = func(name string) string { return "a, err := NewString(value)\n" + wrap(name) }
+		decode = func(string) string { return "value = String(a)" }
+	default:
+		goType = "[]byte"
+		encode = func(name string) string { return "a, err := NewBytes(value)\n" + wrap(name) }
+		decode = func(string) string { return "value = Bytes(a)" }
+	}
+	return
+}
+
+func (g *generator) accessors(attr *radius.DictAttribute) {
+	switch {
+	case attr.Vendor != 0:
+		g.vendorAccessors(attr)
+	case len(attr.OID) == 2:
+		g.extendedAccessors(attr)
+	case attr.HasTag:
+		g.taggedAccessors(attr)
+	default:
+		g.standardAccessors(attr)
+	}
+}
+
+func (g *generator) standardAccessors(attr *radius.DictAttribute) {
+	ident, name := identifier(attr.Name), attr.Name
+	goType, encode, decode := g.codec(attr)
+
+	g.p("// %s_Get returns the first %s of p, or ErrNoAttribute.", ident, name)
+	g.p("func (p *Packet) %s_Get() (value %s, err error) {", ident, goType)
+	g.p("value, ok, err := p.%s_Lookup()", ident)
+	g.p("if err == nil && !ok {\nerr = ErrNoAttribute\n}")
+	g.p("return\n}\n")
+
+	g.p("// %s_Gets returns every %s of p.", ident, name)
+	g.p("func (p *Packet) %s_Gets() (values []%s, err error) {", ident, goType)
+	g.p("for _, avp := range p.Attributes {")
+	g.p("if avp.Type != %s_Type {\ncontinue\n}", ident)
+	g.p("a := avp.Attribute")
+	g.p("var value %s", goType)
+	g.p("%s", decode(name))
+	g.p("values = append(values, value)")
+	g.p("}\nreturn\n}\n")
+
+	g.p("// %s_Lookup returns the first %s of p. ok is false if there is none.", ident, name)
+	g.p("func (p *Packet) %s_Lookup() (value %s, ok bool, err error) {", ident, goType)
+	g.p("a, ok := p.Attributes.Lookup(%s_Type)", ident)
+	g.p("if !ok {\nreturn\n}")
+	g.p("%s", decode(name))
+	g.p("return\n}\n")
+
+	g.p("// %s_Set replaces every %s of p with value.", ident, name)
+	g.p("func (p *Packet) %s_Set(value %s) (err error) {", ident, goType)
+	g.p("%s", encode(name))
+	g.p("p.Attributes.Set(%s_Type, a)", ident)
+	g.p("return\n}\n")
+
+	g.p("// %s_Add appends value as a %s to p.", ident, name)
+	g.p("func (p *Packet) %s_Add(value %s) (err error) {", ident, goType)
+	g.p("%s", encode(name))
+	g.p("p.Attributes.Add(%s_Type, a)", ident)
+	g.p("return\n}\n")
+
+	g.p("// %s_Del removes every %s from p.", ident, name)
+	g.p("func (p *Packet) %s_Del() {", ident)
+	g.p("p.Attributes.Del(%s_Type)", ident)
+	g.p("}\n")
+}
+
+func (g *generator) taggedAccessors(attr *radius.DictAttribute) {
+	ident, name := identifier(attr.Name), attr.Name
+	goType, encode, decode := g.codec(attr)
+	integer := attr.DataType == radius.DataTypeInteger
+
+	g.p("// %s_Get returns the first %s of p with the given tag, or", ident, name)
+	g.p("// ErrNoAttribute.")
+	g.p("func (p *Packet) %s_Get(tag byte) (value %s, err error) {", ident, goType)
+	g.p("value, ok, err := p.%s_Lookup(tag)", ident)
+	g.p("if err == nil && !ok {\nerr = ErrNoAttribute\n}")
+	g.p("return\n}\n")
+
+	g.p("// %s_Gets returns every %s of p with the given tag.", ident, name)
+	g.p("func (p *Packet) %s_Gets(tag byte) (values []%s, err error) {", ident, goType)
+	g.p("for _, avp := range p.Attributes {")
+	g.p("if avp.Type != %s_Type {\ncontinue\n}", ident)
+	g.p("t, a := SplitTag(avp.Attribute, %v)", integer)
+	g.p("if t != tag {\ncontinue\n}")
+	g.p("var value %s", goType)
+	g.p("%s", decode(name))
+	g.p("values = append(values, value)")
+	g.p("}\nreturn\n}\n")
+
+	g.p("// %s_Lookup returns the first %s of p with the given tag. ok is", ident, name)
+	g.p("// false if there is none.")
+	g.p("func (p *Packet) %s_Lookup(tag byte) (value %s, ok bool, err error) {", ident, goType)
+	g.p("for _, avp := range p.Attributes {")
+	g.p("if avp.Type != %s_Type {\ncontinue\n}", ident)
+	g.p("t, a := SplitTag(avp.Attribute, %v)", integer)
+	g.p("if t != tag {\ncontinue\n}")
+	g.p("ok = true")
+	g.p("%s", decode(name))
+	g.p("return")
+	g.p("}\nreturn\n}\n")
+
+	g.p("// %s_Set replaces every %s of p with the given tag by value.", ident, name)
+	g.p("func (p *Packet) %s_Set(tag byte, value %s) (err error) {", ident, goType)
+	g.p("p.%s_Del(tag)", ident)
+	g.p("return p.%s_Add(tag, value)", ident)
+	g.p("}\n")
+
+	g.p("// %s_Add appends value as a %s with the given tag to p.", ident, name)
+	g.p("func (p *Packet) %s_Add(tag byte, value %s) (err error) {", ident, goType)
+	g.p("%s", encode(name))
+	g.p("a, err = JoinTag(tag, a, %v)", integer)
+	g.p("if err != nil {\nerr = fmt.Errorf(\"radius: %s: %%w\", err)\nreturn\n}", name)
+	g.p("p.Attributes.Add(%s_Type, a)", ident)
+	g.p("return\n}\n")
+	g.imports["fmt"] = true
+
+	g.p("// %s_Del removes every %s with the given tag from p.", ident, name)
+	g.p("func (p *Packet) %s_Del(tag byte) {", ident)
+	g.p("for i := 0; i < len(p.Attributes); {")
+	g.p("avp := p.Attributes[i]")
+	g.p("if t, _ := SplitTag(avp.Attribute, %v); avp.Type == %s_Type && t == tag {", integer, ident)
+	g.p("p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)")
+	g.p("} else {\ni++\n}")
+	g.p("}\n}\n")
+}
+
+func (g *generator) vendorAccessors(attr *radius.DictAttribute) {
+	ident, name := identifier(attr.Name), attr.Name
+	goType, encode, decode := g.codec(attr)
+	vendor, ok := g.dict.VendorByID(attr.Vendor)
+	if !ok {
+		return
+	}
+	ids := fmt.Sprintf("Vendor%s, %s_VendorType", identifier(vendor.Name), ident)
+
+	g.p("// %s_Get returns the first %s of p, or ErrNoAttribute.", ident, name)
+	g.p("func (p *Packet) %s_Get() (value %s, err error) {", ident, goType)
+	g.p("value, ok, err := p.%s_Lookup()", ident)
+	g.p("if err == nil && !ok {\nerr = ErrNoAttribute\n}")
+	g.p("return\n}\n")
+
+	g.p("// %s_Gets returns every %s of p.", ident, name)
+	g.p("func (p *Packet) %s_Gets() (values []%s, err error) {", ident, goType)
+	g.p("attrs, err := p.VSA_Gets(%s)", ids)
+	g.p("if err != nil {\nreturn\n}")
+	g.p("for _, a := range attrs {")
+	g.p("var value %s", goType)
+	g.p("%s", decode(name))
+	g.p("values = append(values, value)")
+	g.p("}\nreturn\n}\n")
+
+	g.p("// %s_Lookup returns the first %s of p. ok is false if there is none.", ident, name)
+	g.p("func (p *Packet) %s_Lookup() (value %s, ok bool, err error) {", ident, goType)
+	g.p("attrs, err := p.VSA_Gets(%s)", ids)
+	g.p("if err != nil || len(attrs) == 0 {\nreturn\n}")
+	g.p("ok = true")
+	g.p("a := attrs[0]")
+	g.p("%s", decode(name))
+	g.p("return\n}\n")
+
+	g.p("// %s_Set replaces every %s of p with value.", ident, name)
+	g.p("func (p *Packet) %s_Set(value %s) (err error) {", ident, goType)
+	g.p("p.%s_Del()", ident)
+	g.p("return p.%s_Add(value)", ident)
+	g.p("}\n")
+
+	g.p("// %s_Add appends value as a %s to p.", ident, name)
+	g.p("func (p *Packet) %s_Add(value %s) (err error) {", ident, goType)
+	g.p("%s", encode(name))
+	g.p("return p.VSA_Add(%s, a)", ids)
+	g.p("}\n")
+
+	g.p("// %s_Del removes every %s from p.", ident, name)
+	g.p("func (p *Packet) %s_Del() {", ident)
+	g.p("p.VSA_Del(%s)", ids)
+	g.p("}\n")
+}
+
+func (g *generator) extendedAccessors(attr *radius.DictAttribute) {
+	ident, name := identifier(attr.Name), attr.Name
+	goType, encode, decode := g.codec(attr)
+	ids := fmt.Sprintf("%s_Type, %s_ExtendedType", identifier(g.extendedParent(attr).Name), ident)
+
+	g.p("// %s_Get returns the first %s of p, or ErrNoAttribute.", ident, name)
+	g.p("func (p *Packet) %s_Get() (value %s, err error) {", ident, goType)
+	g.p("value, ok, err := p.%s_Lookup()", ident)
+	g.p("if err == nil && !ok {\nerr = ErrNoAttribute\n}")
+	g.p("return\n}\n")
+
+	g.p("// %s_Gets returns every %s of p.", ident, name)
+	g.p("func (p *Packet) %s_Gets() (values []%s, err error) {", ident, goType)
+	g.p("attrs, err := p.Extended_Gets(%s)", ids)
+	g.p("if err != nil {\nreturn\n}")
+	g.p("for _, a := range attrs {")
+	g.p("var value %s", goType)
+	g.p("%s", decode(name))
+	g.p("values = append(values, value)")
+	g.p("}\nreturn\n}\n")
+
+	g.p("// %s_Lookup returns the first %s of p. ok is false if there is none.", ident, name)
+	g.p("func (p *Packet) %s_Lookup() (value %s, ok bool, err error) {", ident, goType)
+	g.p("a, ok, err := p.Extended_Lookup(%s)", ids)
+	g.p("if err != nil || !ok {\nreturn\n}")
+	g.p("%s", decode(name))
+	g.p("return\n}\n")
+
+	g.p("// %s_Set replaces every %s of p with value.", ident, name)
+	g.p("func (p *Packet) %s_Set(value %s) (err error) {", ident, goType)
+	g.p("p.%s_Del()", ident)
+	g.p("return p.%s_Add(value)", ident)
+	g.p("}\n")
+
+	g.p("// %s_Add appends value as a %s to p.", ident, name)
+	g.p("func (p *Packet) %s_Add(value %s) (err error) {", ident, goType)
+	g.p("%s", encode(name))
+	g.p("return p.Extended_Add(%s, a)", ids)
+	g.p("}\n")
+
+	g.p("// %s_Del removes every %s from p.", ident, name)
+	g.p("func (p *Packet) %s_Del() {", ident)
+	g.p("p.Extended_Del(%s)", ids)
+	g.p("}\n")
+}
+
+// identifier turns a dictionary name such as "Calling-Station-Id" into a Go
+// identifier such as "CallingStationID".
+func identifier(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "Id" {
+			part = "ID"
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
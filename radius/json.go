@@ -0,0 +1,239 @@
+package radius
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MarshalText returns the name of c, or its number when it has none.
+func (c Code) MarshalText() ([]byte, error) {
+	if name, ok := codeNames[c]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalText sets c from its name or number.
+func (c *Code) UnmarshalText(text []byte) error {
+	for code, name := range codeNames {
+		if name == string(text) {
+			*c = code
+			return nil
+		}
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("radius: unknown code %q", text)
+	}
+	*c = Code(n)
+	return nil
+}
+
+// packetJSON is the JSON and YAML form of a Packet.
+type packetJSON struct {
+	Code          Code            `json:"code" yaml:"code"`
+	Identifier    byte            `json:"identifier" yaml:"identifier"`
+	Authenticator string          `json:"authenticator" yaml:"authenticator"`
+	Attributes    []attributeJSON `json:"attributes" yaml:"attributes"`
+}
+
+// attributeJSON is an attribute named by the dictionary with a typed value:
+// a string, a number or the text form taken by DictAttribute.Parse. An
+// attribute that would not be encoded back to the same bytes is given by
+// its Type instead, with its raw value in 0x prefixed hex.
+type attributeJSON struct {
+	Name  string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Type  Type        `json:"type,omitempty" yaml:"type,omitempty"`
+	Value interface{} `json:"value" yaml:"value"`
+}
+
+// MarshalJSON encodes p with the attribute names of DefaultDictionary.
+func (p *Packet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(DefaultDictionary().packetJSON(p))
+}
+
+// UnmarshalJSON decodes a packet encoded by MarshalJSON.
+func (p *Packet) UnmarshalJSON(b []byte) error {
+	var v packetJSON
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return DefaultDictionary().fromPacketJSON(p, &v)
+}
+
+// MarshalYAML encodes p like MarshalJSON.
+func (p *Packet) MarshalYAML() (interface{}, error) {
+	return DefaultDictionary().packetJSON(p), nil
+}
+
+// UnmarshalYAML decodes a packet encoded by MarshalYAML.
+func (p *Packet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v packetJSON
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	return DefaultDictionary().fromPacketJSON(p, &v)
+}
+
+func (d *Dictionary) packetJSON(p *Packet) *packetJSON {
+	v := &packetJSON{
+		Code:          p.Code,
+		Identifier:    p.Identifier,
+		Authenticator: "0x" + hex.EncodeToString(p.Authenticator[:]),
+		Attributes:    []attributeJSON{},
+	}
+	for i := 0; i < len(p.Attributes); i++ {
+		n := 1
+		if IsExtended(p.Attributes[i].Type) {
+			if _, last, err := p.Attributes.extendedAt(i); err == nil {
+				n = last - i + 1
+			}
+		}
+		group := p.Attributes[i : i+n]
+		if a, ok := d.attributeJSON(group); ok {
+			v.Attributes = append(v.Attributes, a)
+		} else {
+			for _, avp := range group {
+				v.Attributes = append(v.Attributes, attributeJSON{
+					Type:  avp.Type,
+					Value: "0x" + hex.EncodeToString(avp.Attribute),
+				})
+			}
+		}
+		i += n - 1
+	}
+	return v
+}
+
+// attributeJSON returns the named form of the attributes of group, a single
+// attribute or the fragments of a long extended one, if it encodes back to
+// the same attributes.
+func (d *Dictionary) attributeJSON(group Attributes) (attributeJSON, bool) {
+	var attr *DictAttribute
+	var value Attribute
+	var ok bool
+	avp := group[0]
+	switch {
+	case avp.Type == VendorSpecific_Type:
+		vsas, err := d.ParseVSA(avp.Attribute)
+		if err != nil || len(vsas) != 1 {
+			return attributeJSON{}, false
+		}
+		attr, ok = d.AttributeByOID(vsas[0].Vendor, vsas[0].Type)
+		value = vsas[0].Value
+	case IsExtended(avp.Type):
+		ext, _, err := group.extendedAt(0)
+		if err != nil {
+			return attributeJSON{}, false
+		}
+		attr, ok = d.AttributeByOID(0, uint32(ext.Type), uint32(ext.ExtendedType))
+		value = ext.Value
+	default:
+		attr, ok = d.AttributeByOID(0, uint32(avp.Type))
+		value = avp.Attribute
+	}
+	if !ok || attr.Encrypt != 0 {
+		return attributeJSON{}, false
+	}
+
+	name := attr.Name
+	integer := attr.DataType == DataTypeInteger
+	if attr.HasTag && len(value) > 0 && (!integer || len(value) == 4) {
+		var tag byte
+		tag, value = SplitTag(value, integer)
+		if tag != 0 {
+			name += ":" + strconv.Itoa(int(tag))
+		}
+	}
+	a := attributeJSON{Name: name, Value: typedValue(attr, value)}
+	if a.Value == nil {
+		return attributeJSON{}, false
+	}
+	attrs, err := d.NewAttributes(name, valueText(a.Value))
+	if err != nil || len(attrs) != len(group) {
+		return attributeJSON{}, false
+	}
+	for i, avp := range attrs {
+		if avp.Type != group[i].Type || !bytes.Equal(avp.Attribute, group[i].Attribute) {
+			return attributeJSON{}, false
+		}
+	}
+	return a, true
+}
+
+// typedValue returns value of attr as a string, a number or its text form,
+// or nil when it has none.
+func typedValue(attr *DictAttribute, value Attribute) interface{} {
+	switch attr.DataType {
+	case DataTypeString:
+		if !utf8.Valid(value) {
+			return nil
+		}
+		return string(value)
+	case DataTypeInteger, DataTypeByte, DataTypeShort, DataTypeInteger64:
+		text := attr.Format(value)
+		if n, err := strconv.ParseUint(text, 10, 64); err == nil {
+			return n
+		}
+		return text
+	case DataTypeSigned:
+		if n, err := Signed(value); err == nil {
+			return int64(n)
+		}
+	}
+	return attr.Format(value)
+}
+
+// valueText returns the text form of a decoded JSON or YAML value.
+func valueText(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+func (d *Dictionary) fromPacketJSON(p *Packet, v *packetJSON) error {
+	auth, err := hex.DecodeString(strings.TrimPrefix(v.Authenticator, "0x"))
+	if err != nil || len(auth) != len(p.Authenticator) {
+		return errors.New("radius: invalid authenticator " + strconv.Quote(v.Authenticator))
+	}
+	var attrs Attributes
+	for _, a := range v.Attributes {
+		if a.Value == nil {
+			return errors.New("radius: attribute without value")
+		}
+		text := valueText(a.Value)
+		if a.Name == "" {
+			b, err := hex.DecodeString(strings.TrimPrefix(text, "0x"))
+			if err != nil {
+				return fmt.Errorf("radius: invalid Attr-%d value %q", a.Type, text)
+			}
+			if len(b) > 253 {
+				return errors.New("radius: attribute too large")
+			}
+			attrs = append(attrs, &AVP{Type: a.Type, Attribute: b})
+			continue
+		}
+		avps, err := d.NewAttributes(a.Name, text)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, avps...)
+	}
+	p.Code = v.Code
+	p.Identifier = v.Identifier
+	copy(p.Authenticator[:], auth)
+	p.Attributes = attrs
+	return nil
+}
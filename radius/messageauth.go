@@ -0,0 +1,113 @@
+package radius
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"errors"
+)
+
+var ErrInvalidMessageAuthenticator = errors.New("radius: invalid Message-Authenticator")
+
+func (p *Packet) MessageAuthenticator_Set(secret string) (err error) {
+	return p.setMessageAuthenticator(secret, MaxPacketLength)
+}
+
+// setMessageAuthenticator is MessageAuthenticator_Set for packets of at most
+// max bytes.
+func (p *Packet) setMessageAuthenticator(secret string, max int) (err error) {
+
+	msgAuth := make([]byte, 16)
+	a, err := NewBytes(msgAuth)
+	if err != nil {
+		return err
+	}
+	p.Set(MessageAuthenticator_Type, a)
+
+	result, err := p.messageAuthenticator(p.Authenticator, secret, max)
+	if err != nil {
+		return err
+	}
+
+	a, err = NewBytes(result)
+	if err != nil {
+		return
+	}
+	p.Set(MessageAuthenticator_Type, a)
+	return
+}
+
+// MessageAuthenticator_Prepend places Message-Authenticator as the first
+// attribute of p and signs it. This is the BlastRADIUS mitigation for
+// requests; servers should do the same in their replies.
+func (p *Packet) MessageAuthenticator_Prepend(secret string) (err error) {
+	p.Del(MessageAuthenticator_Type)
+	p.Attributes = append(Attributes{{
+		Type:      MessageAuthenticator_Type,
+		Attribute: make(Attribute, 16),
+	}}, p.Attributes...)
+	return p.MessageAuthenticator_Set(secret)
+}
+
+// MessageAuthenticator_IsFirst reports whether Message-Authenticator is the
+// first attribute of p.
+func (p *Packet) MessageAuthenticator_IsFirst() bool {
+	return len(p.Attributes) > 0 && p.Attributes[0].Type == MessageAuthenticator_Type
+}
+
+// MessageAuthenticator_Verify checks the Message-Authenticator of p, a reply
+// to req. As required by RFC 3579 section 3.2 the HMAC-MD5 is computed over
+// the reply with its Authenticator field replaced by the one of the request.
+func (p *Packet) MessageAuthenticator_Verify(req *Packet, secret string) (err error) {
+	return p.verifyMessageAuthenticator(req, secret, MaxPacketLength)
+}
+
+// verifyMessageAuthenticator is MessageAuthenticator_Verify for packets of at
+// most max bytes.
+func (p *Packet) verifyMessageAuthenticator(req *Packet, secret string, max int) (err error) {
+	value, ok := p.Lookup(MessageAuthenticator_Type)
+	if !ok {
+		return ErrNoAttribute
+	}
+	if len(value) != 16 {
+		return ErrInvalidMessageAuthenticator
+	}
+	result, err := p.messageAuthenticator(req.Authenticator, secret, max)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(result, value) {
+		return ErrInvalidMessageAuthenticator
+	}
+	return
+}
+
+// messageAuthenticator computes the HMAC-MD5 of p keyed with secret, using
+// authenticator in place of p.Authenticator and a zeroed
+// Message-Authenticator value. p is at most max bytes long.
+func (p *Packet) messageAuthenticator(authenticator [16]byte, secret string, max int) ([]byte, error) {
+	q := &Packet{
+		Code:          p.Code,
+		Identifier:    p.Identifier,
+		Authenticator: authenticator,
+		Attributes:    make(Attributes, len(p.Attributes)),
+	}
+	for i, avp := range p.Attributes {
+		if avp.Type == MessageAuthenticator_Type {
+			avp = &AVP{Type: MessageAuthenticator_Type, Attribute: make(Attribute, 16)}
+		}
+		q.Attributes[i] = avp
+	}
+
+	chunk, err := q.marshal(max)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(md5.New, []byte(secret))
+	mac.Write(chunk)
+	result := mac.Sum(nil)
+	if len(result) != 16 {
+		return nil, errors.New("hmac failed")
+	}
+	return result, nil
+}
@@ -1,14 +1,21 @@
 package radius
 
 import (
+	"crypto/md5"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/binary"
 	"errors"
+	"strconv"
 )
 
 // MaxPacketLength is the maximum wire length of a RADIUS packet.
 const MaxPacketLength = 4096
 
+// MaxStreamPacketLength is the maximum wire length of a RADIUS packet over
+// TCP and TLS, which RFC 7930 raises to the limit of the Length field.
+const MaxStreamPacketLength = 65535
+
 // Code defines the RADIUS packet type.
 type Code int
 
@@ -31,6 +38,37 @@ const (
 	CodeReserved           Code = 255
 )
 
+var (
+	ErrIdentifierMismatch   = errors.New("radius: response identifier does not match request")
+	ErrInvalidAuthenticator = errors.New("radius: invalid authenticator")
+)
+
+var codeNames = map[Code]string{
+	CodeAccessRequest:      "Access-Request",
+	CodeAccessAccept:       "Access-Accept",
+	CodeAccessReject:       "Access-Reject",
+	CodeAccountingRequest:  "Accounting-Request",
+	CodeAccountingResponse: "Accounting-Response",
+	CodeAccessChallenge:    "Access-Challenge",
+	CodeStatusServer:       "Status-Server",
+	CodeStatusClient:       "Status-Client",
+	CodeDisconnectRequest:  "Disconnect-Request",
+	CodeDisconnectACK:      "Disconnect-ACK",
+	CodeDisconnectNAK:      "Disconnect-NAK",
+	CodeCoARequest:         "CoA-Request",
+	CodeCoAACK:             "CoA-ACK",
+	CodeCoANAK:             "CoA-NAK",
+	CodeReserved:           "Reserved",
+}
+
+// String returns the RFC name of the packet code.
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return "Code(" + strconv.Itoa(int(c)) + ")"
+}
+
 type Packet struct {
 	Code          Code
 	Identifier    byte
@@ -50,11 +88,18 @@ func New() *Packet {
 	return packet
 }
 
-func NewReply(req *Packet) *Packet {
-	packet := &Packet{
-		Code:       CodeAccessRequest,
-		Identifier: req.Identifier + 1,
+// NewAccountingRequest creates a new Accounting-Request. Its Request
+// Authenticator is computed by Encode.
+func NewAccountingRequest() *Packet {
+	return &Packet{
+		Code: CodeAccountingRequest,
 	}
+}
+
+// NewReply creates the Access-Request answering the Access-Challenge req,
+// echoing its State. Client.Exchange assigns the Identifier it is sent with.
+func NewReply(req *Packet) *Packet {
+	packet := &Packet{Code: CodeAccessRequest}
 	if _, err := rand.Read(packet.Authenticator[:]); err != nil {
 		return nil
 	}
@@ -68,12 +113,17 @@ func NewReply(req *Packet) *Packet {
 // Parse parses an encoded RADIUS packet b. An error is returned if the packet
 // is malformed.
 func Parse(b []byte) (*Packet, error) {
+	return parse(b, MaxPacketLength)
+}
+
+// parse is Parse for packets of at most max bytes.
+func parse(b []byte, max int) (*Packet, error) {
 	if len(b) < 20 {
 		return nil, errors.New("radius: packet not at least 20 bytes long")
 	}
 
 	length := int(binary.BigEndian.Uint16(b[2:4]))
-	if length < 20 || length > MaxPacketLength || len(b) < length {
+	if length < 20 || length > max || len(b) < length {
 		return nil, errors.New("radius: invalid packet length")
 	}
 
@@ -98,12 +148,17 @@ func Parse(b []byte) (*Packet, error) {
 // to be sent to a RADIUS client and requires the authenticator to be
 // calculated.
 func (p *Packet) MarshalBinary() ([]byte, error) {
+	return p.marshal(MaxPacketLength)
+}
+
+// marshal is MarshalBinary for packets of at most max bytes.
+func (p *Packet) marshal(max int) ([]byte, error) {
 	attributesLen, err := AttributesEncodedLen(p.Attributes)
 	if err != nil {
 		return nil, err
 	}
 	size := 20 + attributesLen
-	if size > MaxPacketLength {
+	if size > max {
 		return nil, errors.New("radius: packet is too large")
 	}
 	b := make([]byte, size)
@@ -114,3 +169,156 @@ func (p *Packet) MarshalBinary() ([]byte, error) {
 	p.Attributes.encodeTo(b[20:])
 	return b, nil
 }
+
+// hashedRequest reports whether the Request Authenticator of a packet with
+// code c is an MD5 hash rather than random, as for Accounting-Request
+// (RFC 2866) and CoA-Request and Disconnect-Request (RFC 5176).
+func hashedRequest(c Code) bool {
+	switch c {
+	case CodeAccountingRequest, CodeCoARequest, CodeDisconnectRequest:
+		return true
+	}
+	return false
+}
+
+// isRequest reports whether c is the code of a request.
+func isRequest(c Code) bool {
+	switch c {
+	case CodeAccessRequest, CodeStatusServer:
+		return true
+	}
+	return hashedRequest(c)
+}
+
+// RequestAuthenticator computes the Request Authenticator of an
+// Accounting-Request, CoA-Request or Disconnect-Request p, i.e.
+// MD5(Code+ID+Length+16 zero octets+Attributes+Secret).
+func (p *Packet) RequestAuthenticator(secret string) ([16]byte, error) {
+	return p.ResponseAuthenticator(&Packet{}, secret)
+}
+
+// Encode returns the request p in wire format, ready to be sent. The
+// Message-Authenticator, if p has one, is signed. Accounting-Request,
+// CoA-Request and Disconnect-Request get their hashed Request Authenticator,
+// which is also stored in p.Authenticator; other requests keep their random
+// one.
+func (p *Packet) Encode(secret string) ([]byte, error) {
+	return p.encode(secret, MaxPacketLength)
+}
+
+// encode is Encode for packets of at most max bytes.
+func (p *Packet) encode(secret string, max int) ([]byte, error) {
+	if !isRequest(p.Code) {
+		return nil, errors.New("radius: Encode of a reply, use EncodeReply")
+	}
+	if hashedRequest(p.Code) {
+		p.Authenticator = [16]byte{}
+	}
+	if _, ok := p.Lookup(MessageAuthenticator_Type); ok {
+		if err := p.setMessageAuthenticator(secret, max); err != nil {
+			return nil, err
+		}
+	}
+	if hashedRequest(p.Code) {
+		auth, err := p.responseAuthenticator(&Packet{}, secret, max)
+		if err != nil {
+			return nil, err
+		}
+		p.Authenticator = auth
+	}
+	return p.marshal(max)
+}
+
+// EncodeReply returns p, a reply to req, in wire format. The
+// Message-Authenticator, if p has one, is signed and the Response
+// Authenticator is stored in p.Authenticator.
+func (p *Packet) EncodeReply(req *Packet, secret string) ([]byte, error) {
+	return p.encodeReply(req, secret, MaxPacketLength)
+}
+
+// encodeReply is EncodeReply for packets of at most max bytes.
+func (p *Packet) encodeReply(req *Packet, secret string, max int) ([]byte, error) {
+	if isRequest(p.Code) {
+		return nil, errors.New("radius: EncodeReply of a request, use Encode")
+	}
+	p.Identifier = req.Identifier
+	if _, ok := p.Lookup(MessageAuthenticator_Type); ok {
+		p.Authenticator = req.Authenticator
+		if err := p.setMessageAuthenticator(secret, max); err != nil {
+			return nil, err
+		}
+	}
+	auth, err := p.responseAuthenticator(req, secret, max)
+	if err != nil {
+		return nil, err
+	}
+	p.Authenticator = auth
+	return p.marshal(max)
+}
+
+// VerifyRequest checks the hashed Request Authenticator of an
+// Accounting-Request, CoA-Request or Disconnect-Request p, and its
+// Message-Authenticator if it has one. It returns ErrInvalidAuthenticator or
+// ErrInvalidMessageAuthenticator if they were not generated with secret.
+func (p *Packet) VerifyRequest(secret string) error {
+	if !hashedRequest(p.Code) {
+		return errors.New("radius: " + p.Code.String() + " has a random authenticator")
+	}
+	auth, err := p.RequestAuthenticator(secret)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(auth[:], p.Authenticator[:]) != 1 {
+		return ErrInvalidAuthenticator
+	}
+	if _, ok := p.Lookup(MessageAuthenticator_Type); ok {
+		return p.MessageAuthenticator_Verify(&Packet{}, secret)
+	}
+	return nil
+}
+
+// ResponseAuthenticator computes the Response Authenticator of p as a reply
+// to req, i.e. MD5(Code+ID+Length+RequestAuth+Attributes+Secret).
+func (p *Packet) ResponseAuthenticator(req *Packet, secret string) ([16]byte, error) {
+	return p.responseAuthenticator(req, secret, MaxPacketLength)
+}
+
+// responseAuthenticator is ResponseAuthenticator for packets of at most max
+// bytes.
+func (p *Packet) responseAuthenticator(req *Packet, secret string, max int) ([16]byte, error) {
+	var auth [16]byte
+	b, err := p.marshal(max)
+	if err != nil {
+		return auth, err
+	}
+	copy(b[4:20], req.Authenticator[:])
+
+	h := md5.New()
+	h.Write(b)
+	h.Write([]byte(secret))
+	copy(auth[:], h.Sum(nil))
+	return auth, nil
+}
+
+// VerifyResponse checks that p is an authentic reply to req. It returns
+// ErrIdentifierMismatch if p answers another request and
+// ErrInvalidAuthenticator if the Response Authenticator was not generated
+// with secret, which usually means the shared secret is wrong.
+func (p *Packet) VerifyResponse(req *Packet, secret string) error {
+	return p.verifyResponse(req, secret, MaxPacketLength)
+}
+
+// verifyResponse is VerifyResponse for packets of at most max bytes.
+func (p *Packet) verifyResponse(req *Packet, secret string, max int) error {
+	if p.Identifier != req.Identifier {
+		return ErrIdentifierMismatch
+	}
+	auth, err := p.responseAuthenticator(req, secret, max)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(auth[:], p.Authenticator[:]) != 1 {
+		return ErrInvalidAuthenticator
+	}
+	return nil
+}
@@ -0,0 +1,1370 @@
+package radius
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pion/dtls/v2"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPacket_VerifyResponse(t *testing.T) {
+	req := New()
+	req.Identifier = 7
+	req.UserName_Set("username")
+
+	reply := &Packet{
+		Code:       CodeAccessAccept,
+		Identifier: req.Identifier,
+	}
+	auth, err := reply.ResponseAuthenticator(req, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply.Authenticator = auth
+
+	tests := []struct {
+		name   string
+		id     byte
+		secret string
+		want   error
+	}{
+		{name: "valid", id: 7, secret: "secret", want: nil},
+		{name: "wrong secret", id: 7, secret: "other", want: ErrInvalidAuthenticator},
+		{name: "wrong identifier", id: 8, secret: "secret", want: ErrIdentifierMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := *reply
+			r.Identifier = tt.id
+			if err := r.VerifyResponse(req, tt.secret); err != tt.want {
+				t.Errorf("VerifyResponse() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPacket_MessageAuthenticator_Verify(t *testing.T) {
+	req := New()
+	req.MessageAuthenticator_Set("secret")
+
+	reply := &Packet{
+		Code:       CodeAccessChallenge,
+		Identifier: req.Identifier,
+	}
+	reply.EAPMessage_Set([]byte{1, 2, 0, 6, 25, 0x20})
+	reply.Set(MessageAuthenticator_Type, make(Attribute, 16))
+	mac, err := reply.messageAuthenticator(req.Authenticator, "secret", MaxPacketLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply.Set(MessageAuthenticator_Type, mac)
+
+	if err := reply.MessageAuthenticator_Verify(req, "secret"); err != nil {
+		t.Errorf("MessageAuthenticator_Verify() = %v, want nil", err)
+	}
+	if err := reply.MessageAuthenticator_Verify(req, "other"); err != ErrInvalidMessageAuthenticator {
+		t.Errorf("MessageAuthenticator_Verify() = %v, want %v", err, ErrInvalidMessageAuthenticator)
+	}
+	reply.Del(MessageAuthenticator_Type)
+	if err := reply.MessageAuthenticator_Verify(req, "secret"); err != ErrNoAttribute {
+		t.Errorf("MessageAuthenticator_Verify() = %v, want %v", err, ErrNoAttribute)
+	}
+}
+
+func TestPacket_MessageAuthenticator_Prepend(t *testing.T) {
+	p := New()
+	p.UserName_Set("username")
+	p.MessageAuthenticator_Set("secret")
+	if p.MessageAuthenticator_IsFirst() {
+		t.Fatal("MessageAuthenticator_Set() placed attribute first")
+	}
+	p.MessageAuthenticator_Prepend("secret")
+	if !p.MessageAuthenticator_IsFirst() {
+		t.Fatal("MessageAuthenticator_Prepend() did not place attribute first")
+	}
+	if n := len(p.Attributes); n != 2 {
+		t.Errorf("len(Attributes) = %d, want 2", n)
+	}
+}
+
+func TestEncryptUserPassword(t *testing.T) {
+	var auth [16]byte
+	copy(auth[:], "0123456789abcdef")
+	tests := []struct {
+		name     string
+		password string
+		size     int
+	}{
+		{name: "empty", password: "", size: 16},
+		{name: "short", password: "password", size: 16},
+		{name: "block", password: "0123456789abcdef", size: 16},
+		{name: "long", password: "a password longer than sixteen bytes", size: 48},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := EncryptUserPassword([]byte(tt.password), "secret", auth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(enc) != tt.size {
+				t.Errorf("len(EncryptUserPassword()) = %d, want %d", len(enc), tt.size)
+			}
+			dec, err := DecryptUserPassword(enc, "secret", auth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(dec) != tt.password {
+				t.Errorf("DecryptUserPassword() = %q, want %q", dec, tt.password)
+			}
+		})
+	}
+}
+
+func TestCHAPPassword(t *testing.T) {
+	challenge := make([]byte, 16)
+	for i := range challenge {
+		challenge[i] = byte(i)
+	}
+	want := "0723ede83231c0bc7b7f00c30fc578cadc"
+	if got := fmt.Sprintf("%x", CHAPPassword(7, "password", challenge)); got != want {
+		t.Errorf("CHAPPassword() = %s, want %s", got, want)
+	}
+
+	// With the Request Authenticator as the challenge.
+	p := New()
+	copy(p.Authenticator[:], challenge)
+	if err := p.CHAPPassword_Set(7, "password", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.Lookup(CHAPChallenge_Type); ok {
+		t.Error("CHAP-Challenge sent with the Request Authenticator as challenge")
+	}
+	if v, _ := p.Lookup(CHAPPassword_Type); fmt.Sprintf("%x", []byte(v)) != want {
+		t.Errorf("CHAP-Password = %x, want %s", []byte(v), want)
+	}
+	if err := p.CHAPPassword_Verify("password"); err != nil {
+		t.Errorf("CHAPPassword_Verify() = %v", err)
+	}
+	if err := p.CHAPPassword_Verify("wrong"); err == nil {
+		t.Error("CHAPPassword_Verify() of a wrong password succeeded")
+	}
+
+	// With a CHAP-Challenge attribute.
+	p = New()
+	challenge, err := NewCHAPChallenge()
+	if err != nil || len(challenge) != 16 {
+		t.Fatalf("NewCHAPChallenge() = %x, %v", challenge, err)
+	}
+	p.CHAPPassword_Set(challenge[0], "password", challenge)
+	if v, _ := p.Lookup(CHAPChallenge_Type); string(v) != string(challenge) {
+		t.Errorf("CHAP-Challenge = %x, want %x", []byte(v), challenge)
+	}
+	if err := p.CHAPPassword_Verify("password"); err != nil {
+		t.Errorf("CHAPPassword_Verify() = %v", err)
+	}
+	p.Set(CHAPPassword_Type, Attribute{1, 2})
+	if err := p.CHAPPassword_Verify("password"); err == nil {
+		t.Error("CHAPPassword_Verify() of a short CHAP-Password succeeded")
+	}
+	p.Del(CHAPPassword_Type)
+	if err := p.CHAPPassword_Verify("password"); err != ErrNoAttribute {
+		t.Errorf("CHAPPassword_Verify() without CHAP-Password = %v", err)
+	}
+}
+
+func TestEncryptSalted(t *testing.T) {
+	var auth [16]byte
+	copy(auth[:], "0123456789abcdef")
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	enc, err := EncryptSalted(key, "secret", auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 2+48 {
+		t.Errorf("len(EncryptSalted()) = %d, want 50", len(enc))
+	}
+	dec, err := DecryptSalted(enc, "secret", auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(dec) != string(key) {
+		t.Errorf("DecryptSalted() = %x, want %x", dec, key)
+	}
+}
+
+func TestPacket_VSA(t *testing.T) {
+	tests := []struct {
+		name   string
+		vendor uint32
+		typ    uint32
+		value  string
+		size   int
+	}{
+		{name: "cisco", vendor: VendorCisco, typ: 1, value: "shell:priv-lvl=15", size: 4 + 2 + 17},
+		{name: "usr", vendor: VendorUSR, typ: 0x9800, value: "vlan", size: 4 + 4 + 4},
+		{name: "starent", vendor: VendorStarent, typ: 300, value: "x", size: 4 + 4 + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := New()
+			if err := p.VSA_Add(tt.vendor, tt.typ, []byte(tt.value)); err != nil {
+				t.Fatal(err)
+			}
+			if n := len(p.Get(VendorSpecific_Type)); n != tt.size {
+				t.Errorf("len(Vendor-Specific) = %d, want %d", n, tt.size)
+			}
+			value, err := p.VSA_Get(tt.vendor, tt.typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(value) != tt.value {
+				t.Errorf("VSA_Get() = %q, want %q", value, tt.value)
+			}
+			p.VSA_Del(tt.vendor, tt.typ)
+			if _, err := p.VSA_Get(tt.vendor, tt.typ); err != ErrNoAttribute {
+				t.Errorf("VSA_Get() after VSA_Del() = %v, want %v", err, ErrNoAttribute)
+			}
+		})
+	}
+}
+
+func TestPacket_GeneratedAccessors(t *testing.T) {
+	p := New()
+	if _, err := p.ServiceType_Get(); err != ErrNoAttribute {
+		t.Errorf("ServiceType_Get() = %v, want %v", err, ErrNoAttribute)
+	}
+	p.ServiceType_Set(ServiceType_Value_FramedUser)
+	if v, err := p.ServiceType_Get(); err != nil || v.String() != "Framed-User" {
+		t.Errorf("ServiceType_Get() = %v, %v", v, err)
+	}
+
+	p.TunnelType_Add(1, TunnelType_Value_VLAN)
+	p.TunnelPrivateGroupID_Add(1, "100")
+	p.TunnelPrivateGroupID_Add(2, "200")
+	if v, err := p.TunnelPrivateGroupID_Get(2); err != nil || v != "200" {
+		t.Errorf("TunnelPrivateGroupID_Get(2) = %q, %v", v, err)
+	}
+	if v, err := p.TunnelType_Get(1); err != nil || v != TunnelType_Value_VLAN {
+		t.Errorf("TunnelType_Get(1) = %v, %v", v, err)
+	}
+
+	p.FramedMTU_Set(1400)
+	p.Set(FramedMTU_Type, Attribute{1, 2})
+	if _, err := p.FramedMTU_Get(); err == nil {
+		t.Error("FramedMTU_Get() of a 2 byte value succeeded")
+	}
+
+	p.ArubaUserRole_Add("guest")
+	if v, err := p.ArubaUserRole_Get(); err != nil || v != "guest" {
+		t.Errorf("ArubaUserRole_Get() = %q, %v", v, err)
+	}
+}
+
+func TestPacket_Extended(t *testing.T) {
+	tests := []struct {
+		name      string
+		typ       Type
+		size      int
+		fragments int
+		wantErr   bool
+	}{
+		{name: "short", typ: ExtendedAttribute1_Type, size: 10, fragments: 1},
+		{name: "too long", typ: ExtendedAttribute1_Type, size: 253, wantErr: true},
+		{name: "long short", typ: ExtendedAttribute5_Type, size: 251, fragments: 1},
+		{name: "long fragmented", typ: ExtendedAttribute5_Type, size: 600, fragments: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := make([]byte, tt.size)
+			for i := range value {
+				value[i] = byte(i)
+			}
+			p := New()
+			err := p.Extended_Add(tt.typ, 7, value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Extended_Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if n := len(p.Attributes); n != tt.fragments {
+				t.Errorf("%d attributes, want %d", n, tt.fragments)
+			}
+
+			b, err := p.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			q, err := Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := q.Extended_Get(tt.typ, 7)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(value) {
+				t.Errorf("Extended_Get() = %x, want %x", got, value)
+			}
+			q.Extended_Del(tt.typ, 7)
+			if len(q.Attributes) != 0 {
+				t.Errorf("%d attributes left after Extended_Del()", len(q.Attributes))
+			}
+		})
+	}
+
+	p := New()
+	p.Extended_Add(ExtendedAttribute5_Type, 7, make([]byte, 300))
+	p.Attributes = p.Attributes[:1]
+	if _, err := p.Extended_Get(ExtendedAttribute5_Type, 7); err == nil {
+		t.Error("Extended_Get() of a truncated attribute succeeded")
+	}
+
+	p = New()
+	p.FragStatus_Set(FragStatus_Value_MoreDataPending)
+	if v, err := p.FragStatus_Get(); err != nil || v != FragStatus_Value_MoreDataPending {
+		t.Errorf("FragStatus_Get() = %v, %v", v, err)
+	}
+	lines := DefaultDictionary().FormatAttributes(p.Attributes)
+	if len(lines) != 1 || lines[0] != "Frag-Status = More-Data-Pending" {
+		t.Errorf("FormatAttributes() = %q", lines)
+	}
+}
+
+func TestPacket_JSON(t *testing.T) {
+	p := New()
+	p.Identifier = 9
+	p.Authenticator[0] = 0xab
+	p.UserName_Set("bob")
+	p.UserPassword_Set("secret", "testing123")
+	p.NASPortType_Set(NASPortType_Value_Ethernet)
+	p.NASPort_Set(7)
+	p.FramedIPAddress_Set(net.IPv4(192, 0, 2, 1))
+	p.TunnelType_Add(1, TunnelType_Value_VLAN)
+	p.TunnelPrivateGroupID_Add(2, "200")
+	p.ArubaUserRole_Add("guest")
+	p.FragStatus_Set(FragStatus_Value_MoreDataPending)
+	p.Extended_Add(ExtendedAttribute5_Type, 200, make([]byte, 300))
+	p.Add(FramedMTU_Type, Attribute{1, 2})
+	p.Add(Type(250), Attribute{0xde, 0xad})
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"code":"Access-Request"`,
+		`{"name":"User-Name","value":"bob"}`,
+		`{"name":"NAS-Port-Type","value":"Ethernet"}`,
+		`{"name":"NAS-Port","value":7}`,
+		`{"name":"Framed-IP-Address","value":"192.0.2.1"}`,
+		`{"name":"Tunnel-Type:1","value":"VLAN"}`,
+		`{"name":"Tunnel-Private-Group-Id:2","value":"200"}`,
+		`{"name":"Aruba-User-Role","value":"guest"}`,
+		`{"name":"Frag-Status","value":"More-Data-Pending"}`,
+		`{"type":2,"value":"0x`,
+		`{"type":12,"value":"0x0102"}`,
+		`{"type":250,"value":"0xdead"}`,
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("json.Marshal() missing %s in %s", want, b)
+		}
+	}
+	want, err := p.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var q Packet
+	if err := json.Unmarshal(b, &q); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := q.MarshalBinary(); string(got) != string(want) {
+		t.Errorf("JSON round trip = %x, want %x", got, want)
+	}
+
+	y, err := yaml.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r Packet
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.MarshalBinary(); string(got) != string(want) {
+		t.Errorf("YAML round trip = %x, want %x\n%s", got, want, y)
+	}
+
+	for _, in := range []string{
+		`{"code":"Access-Request","identifier":1,"authenticator":"0x00","attributes":[]}`,
+		`{"code":"Access-Request","identifier":1,"authenticator":"0x00000000000000000000000000000000","attributes":[{"name":"No-Such-Attribute","value":"x"}]}`,
+		`{"code":"Access-Request","identifier":1,"authenticator":"0x00000000000000000000000000000000","attributes":[{"name":"NAS-Port","value":"x"}]}`,
+		`{"code":"Nope","identifier":1,"authenticator":"0x00000000000000000000000000000000","attributes":[]}`,
+	} {
+		if err := json.Unmarshal([]byte(in), &q); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded", in)
+		}
+	}
+}
+
+func TestDictAttribute_ParseFormat(t *testing.T) {
+	d := NewDictionary()
+	tests := []struct {
+		dataType DataType
+		value    string
+		size     int
+		want     string
+	}{
+		{dataType: DataTypeDate, value: "2024-07-09T12:00:00Z", size: 4},
+		{dataType: DataTypeIPv6Addr, value: "2001:db8::1", size: 16},
+		{dataType: DataTypeIPv6Prefix, value: "2001:db8::/32", size: 2 + 4},
+		{dataType: DataTypeIPv6Prefix, value: "2001:db8::1/128", size: 2 + 16},
+		{dataType: DataTypeIPv4Prefix, value: "10.1.0.0/16", size: 6},
+		{dataType: DataTypeIfID, value: "0000:0000:0000:0001", size: 8},
+		{dataType: DataTypeInteger64, value: "4294967296", size: 8},
+		{dataType: DataTypeSigned, value: "-5", size: 4},
+		{dataType: DataTypeComboIP, value: "192.0.2.1", size: 4},
+		{dataType: DataTypeComboIP, value: "2001:db8::2", size: 16},
+		{dataType: DataTypeIPv4Prefix, value: "10.1.2.3/16", size: 6, want: "10.1.0.0/16"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.dataType)+" "+tt.value, func(t *testing.T) {
+			attr := &DictAttribute{Name: "Test", DataType: tt.dataType}
+			a, err := attr.Parse(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(a) != tt.size {
+				t.Errorf("len(Parse()) = %d, want %d", len(a), tt.size)
+			}
+			want := tt.want
+			if want == "" {
+				want = tt.value
+			}
+			if got := d.format(attr, a); got != want {
+				t.Errorf("Format() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValues_InvalidLength(t *testing.T) {
+	if _, err := Date(Attribute{1, 2, 3}); err == nil {
+		t.Error("Date() of 3 bytes succeeded")
+	}
+	if _, err := IPv6Addr(make(Attribute, 4)); err == nil {
+		t.Error("IPv6Addr() of 4 bytes succeeded")
+	}
+	if _, err := IPv6Prefix(Attribute{0, 64, 0x20, 0x01}); err == nil {
+		t.Error("IPv6Prefix() shorter than its prefix length succeeded")
+	}
+	if _, err := IPv6Prefix(Attribute{0, 8, 0x20, 0x01}); err == nil {
+		t.Error("IPv6Prefix() with bits outside of the prefix succeeded")
+	}
+	if _, err := IfID(make(Attribute, 6)); err == nil {
+		t.Error("IfID() of 6 bytes succeeded")
+	}
+	if _, err := ComboIP(make(Attribute, 8)); err == nil {
+		t.Error("ComboIP() of 8 bytes succeeded")
+	}
+	if _, err := ParseTLV(Attribute{1, 5, 0}); err == nil {
+		t.Error("ParseTLV() of a truncated element succeeded")
+	}
+
+	a, err := NewTLV(&TLV{Type: 1, Value: NewInteger(7)}, &TLV{Type: 2, Value: Attribute("x")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlvs, err := ParseTLV(a)
+	if err != nil || len(tlvs) != 2 || string(tlvs[1].Value) != "x" {
+		t.Errorf("ParseTLV() = %v, %v", tlvs, err)
+	}
+	if a, err := NewTLV(&TLV{Type: 1}); err == nil {
+		t.Errorf("NewTLV() of an empty value = %x", []byte(a))
+	}
+	if _, err := ParseTLV(Attribute{1, 2}); err == nil {
+		t.Error("ParseTLV() of an empty element succeeded")
+	}
+}
+
+func TestPacket_Encode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    Code
+		msgAuth bool
+	}{
+		{name: "accounting", code: CodeAccountingRequest},
+		{name: "accounting with Message-Authenticator", code: CodeAccountingRequest, msgAuth: true},
+		{name: "disconnect", code: CodeDisconnectRequest},
+		{name: "coa", code: CodeCoARequest, msgAuth: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Packet{Code: tt.code, Identifier: 3}
+			p.AcctSessionID_Set("0001")
+			if tt.msgAuth {
+				p.Set(MessageAuthenticator_Type, make(Attribute, 16))
+			}
+			b, err := p.Encode("secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			q, err := Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := q.VerifyRequest("secret"); err != nil {
+				t.Errorf("VerifyRequest() = %v", err)
+			}
+			if err := q.VerifyRequest("wrong"); err != ErrInvalidAuthenticator {
+				t.Errorf("VerifyRequest() with wrong secret = %v, want %v", err, ErrInvalidAuthenticator)
+			}
+			if tt.msgAuth {
+				// The Message-Authenticator of a hashed request is
+				// computed with a zero Request Authenticator.
+				if err := q.MessageAuthenticator_Verify(&Packet{}, "secret"); err != nil {
+					t.Errorf("MessageAuthenticator_Verify() = %v", err)
+				}
+			}
+
+			reply := &Packet{Code: tt.code + 1}
+			b, err = reply.EncodeReply(q, "secret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			r, _ := Parse(b)
+			if err := r.VerifyResponse(q, "secret"); err != nil {
+				t.Errorf("VerifyResponse() = %v", err)
+			}
+		})
+	}
+}
+
+func TestSendDynAuth(t *testing.T) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	go func() {
+		var first []byte
+		for {
+			b := make([]byte, MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			// Drop the first transmission, the retransmission must be
+			// identical.
+			if first == nil {
+				first = b[:n]
+				continue
+			}
+			if string(first) != string(b[:n]) {
+				return
+			}
+			req, _ := Parse(b[:n])
+			reply := &Packet{Code: CodeDisconnectNAK}
+			reply.ErrorCause_Set(ErrorCause_Value_SessionContextNotFound)
+			reply.MessageAuthenticator_Prepend("secret")
+			b, _ = reply.EncodeReply(req, "secret")
+			c.WriteToUDP(b, addr)
+		}
+	}()
+
+	req := &Packet{Code: CodeDisconnectRequest, Identifier: 9}
+	req.AcctSessionID_Set("0001")
+	req.MessageAuthenticator_Prepend("secret")
+	reply, err := SendDynAuth(c.LocalAddr().String(), req, "secret", 50*time.Millisecond, 2)
+	nak, ok := err.(*NAKError)
+	if !ok {
+		t.Fatalf("SendDynAuth() error = %v, want a NAKError", err)
+	}
+	if nak.Code != CodeDisconnectNAK || nak.Cause != ErrorCause_Value_SessionContextNotFound {
+		t.Errorf("NAKError = %+v", nak)
+	}
+	if reply == nil || reply.Identifier != req.Identifier {
+		t.Errorf("reply = %+v", reply)
+	}
+}
+
+func TestSendDynAuth_Retries(t *testing.T) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var requests int32
+	go func() {
+		b := make([]byte, MaxPacketLength)
+		for {
+			if _, _, err := c.ReadFromUDP(b); err != nil {
+				return
+			}
+			atomic.AddInt32(&requests, 1)
+		}
+	}()
+
+	for _, retries := range []int{0, 1, 3} {
+		atomic.StoreInt32(&requests, 0)
+		req := &Packet{Code: CodeCoARequest}
+		req.AcctSessionID_Set("0001")
+		_, err := SendDynAuth(c.LocalAddr().String(), req, "secret", 20*time.Millisecond, retries)
+		if err != ErrTimeout {
+			t.Errorf("retries %d: SendDynAuth() error = %v, want ErrTimeout", retries, err)
+		}
+		// Let the last request reach the server.
+		time.Sleep(10 * time.Millisecond)
+		if n := atomic.LoadInt32(&requests); n != int32(retries+1) {
+			t.Errorf("retries %d: server received %d requests, want %d", retries, n, retries+1)
+		}
+	}
+}
+
+func TestProbe(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    Code
+		msgAuth bool
+		wantErr bool
+	}{
+		{name: "auth", code: CodeAccessAccept, msgAuth: true},
+		{name: "acct", code: CodeAccountingResponse},
+		{name: "auth without Message-Authenticator", code: CodeAccessAccept, wantErr: true},
+		{name: "reject", code: CodeAccessReject, msgAuth: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+			go func() {
+				b := make([]byte, MaxPacketLength)
+				n, addr, err := c.ReadFromUDP(b)
+				if err != nil {
+					return
+				}
+				req, err := Parse(b[:n])
+				if err != nil || req.Code != CodeStatusServer || req.MessageAuthenticator_Verify(req, "secret") != nil {
+					return
+				}
+				reply := &Packet{Code: tt.code}
+				reply.ReplyMessage_Set("up")
+				if tt.msgAuth {
+					reply.MessageAuthenticator_Prepend("secret")
+				}
+				b, _ = reply.EncodeReply(req, "secret")
+				c.WriteToUDP(b, addr)
+			}()
+
+			result, err := Probe(c.LocalAddr().String(), "secret", 100*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Probe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if msg, _ := result.Reply.ReplyMessage_Get(); msg != "up" || result.RTT <= 0 {
+				t.Errorf("Probe() = %v, Reply-Message %q", result.RTT, msg)
+			}
+		})
+	}
+}
+
+func TestClient_Exchange(t *testing.T) {
+	server, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	go func() {
+		for {
+			b := make([]byte, MaxPacketLength)
+			n, addr, err := server.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := Parse(b[:n])
+			if err != nil {
+				continue
+			}
+			// A forged reply first, which the client must drop.
+			forged := &Packet{Code: CodeAccessReject}
+			forged.Identifier = req.Identifier
+			b, _ = forged.EncodeReply(req, "wrong")
+			server.WriteToUDP(b, addr)
+
+			reply := &Packet{Code: CodeAccessAccept}
+			name, _ := req.UserName_Get()
+			reply.ReplyMessage_Set(name)
+			reply.MessageAuthenticator_Set("secret")
+			b, _ = reply.EncodeReply(req, "secret")
+			server.WriteToUDP(b, addr)
+		}
+	}()
+
+	c, err := NewClient(server.LocalAddr().String(), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var mu sync.Mutex
+	dropped := 0
+	c.Dropped = func(data []byte, err error) {
+		mu.Lock()
+		dropped++
+		mu.Unlock()
+	}
+
+	const n = 300
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			p := New()
+			name := strconv.Itoa(i)
+			p.UserName_Set(name)
+			p.MessageAuthenticator_Set("secret")
+			reply, err := c.Exchange(ctx, p)
+			if err == nil {
+				if msg, _ := reply.ReplyMessage_Get(); msg != name {
+					err = fmt.Errorf("reply %q to request %q", msg, name)
+				}
+			}
+			errs <- err
+		}(i)
+	}
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if dropped != n {
+		t.Errorf("%d replies dropped, want %d", dropped, n)
+	}
+}
+
+func TestClient_Retransmit(t *testing.T) {
+	server, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	received := make(chan []byte, 16)
+	go func() {
+		for {
+			b := make([]byte, MaxPacketLength)
+			n, addr, err := server.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			received <- b[:n]
+			req, err := Parse(b[:n])
+			if err != nil {
+				continue
+			}
+			// Only the third transmission of the first request is answered.
+			if name, _ := req.UserName_Get(); name == "answer" && len(received) == 3 {
+				reply := &Packet{Code: CodeAccessAccept}
+				b, _ = reply.EncodeReply(req, "secret")
+				server.WriteToUDP(b, addr)
+			}
+		}
+	}()
+
+	c, err := NewClient(server.LocalAddr().String(), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Retransmit = RetransmitPolicy{IRT: 10 * time.Millisecond, MRC: 2, MRT: 30 * time.Millisecond, MRD: time.Second}
+	var timeouts []time.Duration
+	c.Attempt = func(p *Packet, attempt int, timeout time.Duration) {
+		if attempt != len(timeouts)+1 {
+			t.Errorf("attempt %d after %d", attempt, len(timeouts))
+		}
+		timeouts = append(timeouts, timeout)
+	}
+
+	p := New()
+	p.UserName_Set("answer")
+	if _, err := c.Exchange(context.Background(), p); err != nil {
+		t.Fatal(err)
+	}
+	if len(timeouts) != 3 {
+		t.Fatalf("%d attempts, want 3", len(timeouts))
+	}
+	if timeouts[0] < 9*time.Millisecond || timeouts[0] > 11*time.Millisecond ||
+		timeouts[1] < timeouts[0]*18/10 || timeouts[1] > timeouts[0]*22/10 {
+		t.Errorf("timeouts %v, want about 10ms then twice that", timeouts)
+	}
+	if timeouts[2] > 33*time.Millisecond {
+		t.Errorf("timeout %s above MRT", timeouts[2])
+	}
+	first := <-received
+	for i := 0; i < 2; i++ {
+		if b := <-received; string(b) != string(first) {
+			t.Errorf("retransmission %d differs from the request", i+1)
+		}
+	}
+
+	timeouts = nil
+	p = New()
+	p.UserName_Set("ignore")
+	if _, err := c.Exchange(context.Background(), p); err != ErrTimeout {
+		t.Errorf("Exchange error %v, want ErrTimeout", err)
+	}
+	if len(timeouts) != 3 {
+		t.Errorf("%d attempts, want 3", len(timeouts))
+	}
+}
+
+// poolServer starts a server answering requests signed with secret while
+// *answer is set, and returns its address.
+func poolServer(t *testing.T, secret string, answer *int32) string {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	go func() {
+		for {
+			b := make([]byte, MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := Parse(b[:n])
+			if err != nil || atomic.LoadInt32(answer) == 0 {
+				continue
+			}
+			reply := &Packet{Code: CodeAccessAccept}
+			reply.ReplyMessage_Set(secret)
+			reply.MessageAuthenticator_Set(secret)
+			if b, err := reply.EncodeReply(req, secret); err == nil {
+				c.WriteToUDP(b, addr)
+			}
+		}
+	}()
+	return c.LocalAddr().String()
+}
+
+func TestPool_Exchange(t *testing.T) {
+	primaryUp, secondaryUp := int32(0), int32(1)
+	primary := &Server{Addr: poolServer(t, "primary", &primaryUp), Secret: "primary"}
+	secondary := &Server{Addr: poolServer(t, "secondary", &secondaryUp), Secret: "secondary"}
+	pool := NewPool(primary, secondary)
+	defer pool.Close()
+	pool.Retransmit = &RetransmitPolicy{IRT: 10 * time.Millisecond, MRC: 1}
+	pool.DeadTime = 50 * time.Millisecond
+	pool.StatusServer = true
+
+	exchange := func() string {
+		p := New()
+		p.MessageAuthenticator_Set("")
+		reply, err := pool.Exchange(context.Background(), p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg, _ := reply.ReplyMessage_Get()
+		return msg
+	}
+	if got := exchange(); got != "secondary" {
+		t.Errorf("reply from %s, want secondary", got)
+	}
+	if !primary.Dead() || secondary.Dead() {
+		t.Errorf("primary dead %v, secondary dead %v", primary.Dead(), secondary.Dead())
+	}
+	if got := pool.Select(); got[0] != secondary {
+		t.Error("dead primary selected first")
+	}
+
+	// The primary is revived by the Status-Server probe it answers.
+	atomic.StoreInt32(&primaryUp, 1)
+	deadline := time.Now().Add(2 * time.Second)
+	for primary.Dead() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := exchange(); got != "primary" {
+		t.Errorf("reply from %s, want revived primary", got)
+	}
+
+	pool.Strategy = StrategyRoundRobin
+	first, second := pool.Select()[0], pool.Select()[0]
+	if first == second {
+		t.Error("round robin selected the same server twice")
+	}
+}
+
+func TestServer_Revive(t *testing.T) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var probes int32
+	go func() {
+		b := make([]byte, MaxPacketLength)
+		for {
+			n, _, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			if req, err := Parse(b[:n]); err == nil && req.Code == CodeStatusServer {
+				atomic.AddInt32(&probes, 1)
+			}
+		}
+	}()
+	s := &Server{Addr: c.LocalAddr().String(), Secret: "secret"}
+	pool := NewPool(s)
+	defer pool.Close()
+	pool.DeadTime = 20 * time.Millisecond
+	pool.StatusServer = true
+
+	// A server found alive by an exchange and dead again before the next
+	// probe keeps a single revive goroutine.
+	s.markDead(ErrTimeout)
+	s.mu.Lock()
+	s.dead = false
+	s.mu.Unlock()
+	s.markDead(ErrTimeout)
+
+	// The unanswered probe waits for statusTimeout, so each goroutine
+	// sends one.
+	time.Sleep(100 * time.Millisecond)
+	if n := atomic.LoadInt32(&probes); n != 1 {
+		t.Errorf("%d probes, want 1", n)
+	}
+}
+
+// testTLSConfigs returns the configurations of a RadSec server and client
+// authenticating each other with the same self-signed certificate.
+func testTLSConfigs(t *testing.T) (server, client *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "radsec"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	server = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	client = &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool}
+	return server, client
+}
+
+func TestTLSClient(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	var statusServers, conns int32
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&conns, 1)
+			go func() {
+				defer conn.Close()
+				for {
+					data, err := readStream(conn, MaxStreamPacketLength)
+					if err != nil {
+						return
+					}
+					req, err := Parse(data)
+					if err != nil {
+						return
+					}
+					reply := &Packet{Code: CodeAccessAccept}
+					if req.Code == CodeStatusServer {
+						atomic.AddInt32(&statusServers, 1)
+					}
+					reply.MessageAuthenticator_Set(RadSecSecret)
+					// Replies are written in two parts to exercise framing.
+					b, _ := reply.EncodeReply(req, RadSecSecret)
+					conn.Write(b[:3])
+					conn.Write(b[3:])
+				}
+			}()
+		}
+	}()
+
+	if _, err := NewTLSClient(l.Addr().String(), &tls.Config{RootCAs: clientConfig.RootCAs}); err == nil {
+		t.Error("NewTLSClient without a client certificate succeeded")
+	}
+	c, err := NewTLSClient(l.Addr().String(), clientConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Keepalive = 20 * time.Millisecond
+	c.Retransmit.MRD = time.Second
+	for i := 0; i < 10; i++ {
+		p := New()
+		p.UserName_Set("user")
+		if _, err := c.Exchange(context.Background(), p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(100 * time.Millisecond)
+	if n := atomic.LoadInt32(&conns); n != 1 {
+		t.Errorf("%d connections, want 1", n)
+	}
+	if n := atomic.LoadInt32(&statusServers); n == 0 {
+		t.Error("no Status-Server keepalive")
+	}
+	if c.failed() {
+		t.Error("client failed")
+	}
+}
+
+func TestTCPClient(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	var conns int32
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			n := atomic.AddInt32(&conns, 1)
+			go func() {
+				defer conn.Close()
+				for {
+					data, err := readStream(conn, MaxStreamPacketLength)
+					if err != nil {
+						return
+					}
+					// The first connection is closed with the request
+					// outstanding, the second after one reply.
+					if n == 1 {
+						return
+					}
+					req, _ := parse(data, MaxStreamPacketLength)
+					reply := &Packet{Code: CodeAccessAccept}
+					reply.ReplyMessage_Set(strconv.Itoa(len(data)))
+					b, _ := reply.EncodeReply(req, "secret")
+					conn.Write(b)
+					if n == 2 {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	c, err := Dial("tcp", l.Addr().String(), "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Retransmit.MRD = time.Second
+	for i, size := range []int{100, 5000} {
+		p := New()
+		// An RFC 7930 packet larger than 4096 bytes.
+		for len(p.Attributes)*200 < size {
+			p.Add(Class_Type, make(Attribute, 198))
+		}
+		reply, err := c.Exchange(context.Background(), p)
+		if err != nil {
+			t.Fatalf("exchange %d: %s", i, err)
+		}
+		if msg, _ := reply.ReplyMessage_Get(); msg != strconv.Itoa(20+len(p.Attributes)*200) {
+			t.Errorf("server read %s bytes", msg)
+		}
+	}
+	if n := atomic.LoadInt32(&conns); n != 3 {
+		t.Errorf("%d connections, want 3", n)
+	}
+}
+
+func TestTCPClient_Large(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	errs := make(chan error, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, err := readStream(conn, MaxStreamPacketLength)
+		if err != nil {
+			errs <- err
+			return
+		}
+		req, err := parse(data, MaxStreamPacketLength)
+		if err != nil {
+			errs <- err
+			return
+		}
+		// A signed Accounting-Request of more than 4096 bytes.
+		auth, err := req.responseAuthenticator(&Packet{}, "secret", MaxStreamPacketLength)
+		if err == nil && auth != req.Authenticator {
+			err = ErrInvalidAuthenticator
+		}
+		if err == nil {
+			err = req.verifyMessageAuthenticator(&Packet{}, "secret", MaxStreamPacketLength)
+		}
+		errs <- err
+
+		// Answered by a signed reply of more than 4096 bytes.
+		reply := &Packet{Code: CodeAccountingResponse}
+		reply.MessageAuthenticator_Prepend("secret")
+		for i := 0; i < 25; i++ {
+			reply.Add(Class_Type, make(Attribute, 200))
+		}
+		b, err := reply.encodeReply(req, "secret", MaxStreamPacketLength)
+		if err != nil {
+			return
+		}
+		conn.Write(b)
+	}()
+
+	c, err := Dial("tcp", l.Addr().String(), "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Retransmit.MRD = time.Second
+	p := NewAccountingRequest()
+	p.MessageAuthenticator_Prepend("secret")
+	for i := 0; i < 25; i++ {
+		p.Add(Class_Type, make(Attribute, 200))
+	}
+	reply, err := c.Exchange(context.Background(), p)
+	if err := <-errs; err != nil {
+		t.Errorf("server: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if classes, _ := reply.Class_Gets(); len(classes) != 25 {
+		t.Errorf("reply with %d Class attributes, want 25", len(classes))
+	}
+}
+
+func TestTCPClient_MRD(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for n := 1; ; n++ {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			// The first connection is closed late with the request
+			// outstanding, the second never answers.
+			go func(n int) {
+				defer conn.Close()
+				if _, err := readStream(conn, MaxStreamPacketLength); err != nil || n > 1 {
+					io.Copy(io.Discard, conn)
+					return
+				}
+				time.Sleep(150 * time.Millisecond)
+			}(n)
+		}
+	}()
+
+	c, err := Dial("tcp", l.Addr().String(), "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.Retransmit.MRD = 200 * time.Millisecond
+	start := time.Now()
+	if _, err := c.Exchange(context.Background(), New()); err != ErrTimeout {
+		t.Fatalf("Exchange() error = %v, want ErrTimeout", err)
+	}
+	if d := time.Since(start); d > 300*time.Millisecond {
+		t.Errorf("Exchange() took %s, want MRD from the first transmission", d)
+	}
+}
+
+func TestDTLSClient(t *testing.T) {
+	serverConfig, clientConfig := testTLSConfigs(t)
+	l, err := dtls.Listen("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)}, &dtls.Config{
+		Certificates:         serverConfig.Certificates,
+		ClientCAs:            serverConfig.ClientCAs,
+		ClientAuth:           dtls.RequireAndVerifyClientCert,
+		ExtendedMasterSecret: dtls.RequireExtendedMasterSecret,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				for {
+					b := make([]byte, MaxPacketLength)
+					n, err := conn.Read(b)
+					if err != nil {
+						return
+					}
+					req, err := Parse(b[:n])
+					if err != nil {
+						continue
+					}
+					reply := &Packet{Code: CodeAccessAccept}
+					if b, err := reply.EncodeReply(req, DTLSSecret); err == nil {
+						conn.Write(b)
+					}
+				}
+			}()
+		}
+	}()
+
+	c, err := Dial("dtls", l.Addr().String(), "ignored", clientConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if c.Secret != DTLSSecret {
+		t.Errorf("Secret = %q, want %q", c.Secret, DTLSSecret)
+	}
+	p := New()
+	p.UserName_Set("user")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.Exchange(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPcapWriter(t *testing.T) {
+	var b bytes.Buffer
+	pw, err := NewPcapWriter(&b, "RADIUS shared secret: testing123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("radius packet")
+	now := time.Unix(1700000000, 123456000)
+	nas := &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 40000}
+	server := &net.UDPAddr{IP: net.IPv4(192, 0, 2, 2), Port: 1812}
+	pw.WritePacket(now, nas, server, data)
+	pw.WritePacket(now, &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 40000},
+		&net.TCPAddr{IP: net.ParseIP("2001:db8::2"), Port: 2083}, data)
+	io.WriteString(pw.KeyLog(), "CLIENT_RANDOM 00 11\n")
+	if n := b.Len(); n%4 != 0 {
+		t.Fatalf("header length %d", n)
+	}
+	header := b.Len()
+	if err := pw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() == header {
+		t.Fatal("Flush() wrote nothing")
+	}
+
+	var types []uint32
+	var ips [][]byte
+	for rest := b.Bytes(); len(rest) > 0; {
+		blockType := binary.LittleEndian.Uint32(rest)
+		length := binary.LittleEndian.Uint32(rest[4:])
+		if length%4 != 0 || int(length) > len(rest) || binary.LittleEndian.Uint32(rest[length-4:]) != length {
+			t.Fatalf("invalid block of type %#x and length %d", blockType, length)
+		}
+		body := rest[8 : length-4]
+		types = append(types, blockType)
+		switch blockType {
+		case pcapBlockPacket:
+			us := uint64(binary.LittleEndian.Uint32(body[4:]))<<32 | uint64(binary.LittleEndian.Uint32(body[8:]))
+			if us != uint64(now.UnixNano()/1000) {
+				t.Errorf("timestamp %d, want %d", us, now.UnixNano()/1000)
+			}
+			ips = append(ips, body[20:20+binary.LittleEndian.Uint32(body[12:])])
+		case pcapBlockSecrets:
+			if binary.LittleEndian.Uint32(body) != PcapSecretsTLSKeyLog ||
+				!bytes.HasPrefix(body[8:], []byte("CLIENT_RANDOM 00 11\n")) {
+				t.Errorf("secrets block %q", body)
+			}
+		}
+		rest = rest[length:]
+	}
+	want := []uint32{pcapBlockSection, pcapBlockInterface, pcapBlockSecrets, pcapBlockPacket, pcapBlockPacket}
+	if fmt.Sprint(types) != fmt.Sprint(want) {
+		t.Fatalf("blocks %x, want %x", types, want)
+	}
+
+	v4, v6 := ips[0], ips[1]
+	if v4[0] != 0x45 || checksum(v4[:20], 0) != 0 || !net.IP(v4[16:20]).Equal(server.IP) {
+		t.Errorf("IPv4 header %x", v4[:20])
+	}
+	if port := binary.BigEndian.Uint16(v4[22:]); port != 1812 || !bytes.Equal(v4[28:], data) {
+		t.Errorf("UDP datagram %x", v4[20:])
+	}
+	if v6[0] != 0x60 || v6[6] != 17 || binary.BigEndian.Uint16(v6[42:]) != 2083 || !bytes.Equal(v6[48:], data) {
+		t.Errorf("IPv6 datagram %x", v6)
+	}
+	pseudo := append(append([]byte(nil), v6[8:40]...), 0, 17, 0, byte(len(v6)-40))
+	if checksum(v6[40:], checksumAdd(pseudo, 0)) != 0 {
+		t.Errorf("invalid UDP checksum in %x", v6[40:])
+	}
+}
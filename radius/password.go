@@ -0,0 +1,92 @@
+package radius
+
+import (
+	"crypto/md5"
+	"errors"
+)
+
+// MaxPasswordLength is the longest User-Password RFC 2865 allows.
+const MaxPasswordLength = 128
+
+// EncryptUserPassword hides password as described in RFC 2865 section 5.2:
+// the password is padded to a multiple of 16 bytes and each block is XORed
+// with MD5(secret + previous block), the first previous block being the
+// Request Authenticator.
+func EncryptUserPassword(password []byte, secret string, authenticator [16]byte) ([]byte, error) {
+	if len(password) > MaxPasswordLength {
+		return nil, errors.New("radius: password too long")
+	}
+	size := (len(password) + 15) / 16 * 16
+	if size == 0 {
+		size = 16
+	}
+	enc := make([]byte, size)
+	copy(enc, password)
+
+	last := authenticator[:]
+	for i := 0; i < size; i += 16 {
+		h := md5.New()
+		h.Write([]byte(secret))
+		h.Write(last)
+		b := h.Sum(nil)
+		for j := 0; j < 16; j++ {
+			enc[i+j] ^= b[j]
+		}
+		last = enc[i : i+16]
+	}
+	return enc, nil
+}
+
+// DecryptUserPassword reverses EncryptUserPassword and strips the padding.
+func DecryptUserPassword(value []byte, secret string, authenticator [16]byte) ([]byte, error) {
+	if len(value) < 16 || len(value) > MaxPasswordLength || len(value)%16 != 0 {
+		return nil, errors.New("radius: invalid User-Password length")
+	}
+	dec := make([]byte, len(value))
+
+	last := authenticator[:]
+	for i := 0; i < len(value); i += 16 {
+		h := md5.New()
+		h.Write([]byte(secret))
+		h.Write(last)
+		b := h.Sum(nil)
+		for j := 0; j < 16; j++ {
+			dec[i+j] = value[i+j] ^ b[j]
+		}
+		last = value[i : i+16]
+	}
+	for len(dec) > 0 && dec[len(dec)-1] == 0 {
+		dec = dec[:len(dec)-1]
+	}
+	return dec, nil
+}
+
+// UserPassword_Set hides password with the Request Authenticator of p and
+// sets it as the User-Password attribute.
+func (p *Packet) UserPassword_Set(password, secret string) (err error) {
+	enc, err := EncryptUserPassword([]byte(password), secret, p.Authenticator)
+	if err != nil {
+		return
+	}
+	a, err := NewBytes(enc)
+	if err != nil {
+		return
+	}
+	p.Set(UserPassword_Type, a)
+	return
+}
+
+// UserPassword_Get returns the clear text User-Password of p.
+func (p *Packet) UserPassword_Get(secret string) (value string, err error) {
+	a, ok := p.Lookup(UserPassword_Type)
+	if !ok {
+		err = ErrNoAttribute
+		return
+	}
+	dec, err := DecryptUserPassword(a, secret, p.Authenticator)
+	if err != nil {
+		return
+	}
+	value = string(dec)
+	return
+}
@@ -0,0 +1,246 @@
+package radius
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// PcapSecretsTLSKeyLog is the type of the decryption secrets blocks holding
+// a TLS key log in the NSS format.
+const PcapSecretsTLSKeyLog = 0x544c534b
+
+// pcapng block types and options.
+const (
+	pcapBlockSection   = 0x0a0d0d0a
+	pcapBlockInterface = 0x00000001
+	pcapBlockPacket    = 0x00000006
+	pcapBlockSecrets   = 0x0000000a
+	pcapOptionComment  = 1
+	pcapOptionUserAppl = 4
+	// pcapLinkTypeRaw is LINKTYPE_RAW, packets starting with their IPv4 or
+	// IPv6 header.
+	pcapLinkTypeRaw = 101
+)
+
+// PcapWriter records RADIUS packets in the pcapng format, each in a UDP
+// datagram between the addresses it was exchanged with, so that Wireshark
+// dissects them down to the EAP and PEAP layers. Packets of stream
+// transports are recorded as datagrams too.
+//
+// Packets and secrets are kept until Flush, which writes the secrets first
+// because Wireshark needs them before the packets they decrypt.
+type PcapWriter struct {
+	w       io.Writer
+	mu      sync.Mutex
+	packets bytes.Buffer
+	secrets bytes.Buffer
+	keyLog  bytes.Buffer
+}
+
+// NewPcapWriter writes the section header, with the optional comment, and
+// the raw IP interface of a pcapng file to w.
+//
+// pcapng defines no decryption secrets block for the RADIUS shared secret,
+// so Wireshark cannot take it from the file: the secret that checks the
+// authenticators and hides attributes such as User-Password must be set in
+// its radius.shared_secret preference. Callers may leave it in comment for
+// the reader to copy.
+func NewPcapWriter(w io.Writer, comment string) (*PcapWriter, error) {
+	var options []byte
+	if comment != "" {
+		options = pcapOption(options, pcapOptionComment, []byte(comment))
+	}
+	options = pcapOption(options, pcapOptionUserAppl, []byte("eapol_test"))
+	options = append(options, 0, 0, 0, 0)
+
+	body := make([]byte, 16, 16+len(options))
+	binary.LittleEndian.PutUint32(body[0:], 0x1a2b3c4d)
+	binary.LittleEndian.PutUint16(body[4:], 1)
+	binary.LittleEndian.PutUint16(body[6:], 0)
+	binary.LittleEndian.PutUint64(body[8:], ^uint64(0))
+	body = append(body, options...)
+
+	var b bytes.Buffer
+	writeBlock(&b, pcapBlockSection, body)
+	iface := make([]byte, 8)
+	binary.LittleEndian.PutUint16(iface[0:], pcapLinkTypeRaw)
+	writeBlock(&b, pcapBlockInterface, iface)
+	if _, err := w.Write(b.Bytes()); err != nil {
+		return nil, err
+	}
+	return &PcapWriter{w: w}, nil
+}
+
+// WritePacket records the RADIUS packet data sent from src to dst at t.
+func (pw *PcapWriter) WritePacket(t time.Time, src, dst net.Addr, data []byte) error {
+	ip := ipDatagram(src, dst, data)
+	us := uint64(t.UnixNano() / int64(time.Microsecond))
+	body := make([]byte, 20, 20+len(ip)+3)
+	binary.LittleEndian.PutUint32(body[4:], uint32(us>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(us))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(ip)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(len(ip)))
+	body = append(body, pad4(ip)...)
+
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	writeBlock(&pw.packets, pcapBlockPacket, body)
+	return nil
+}
+
+// WriteSecrets records a decryption secrets block of the given type, such
+// as PcapSecretsTLSKeyLog.
+func (pw *PcapWriter) WriteSecrets(secretsType uint32, data []byte) error {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	pw.writeSecrets(secretsType, data)
+	return nil
+}
+
+func (pw *PcapWriter) writeSecrets(secretsType uint32, data []byte) {
+	body := make([]byte, 8, 8+len(data)+3)
+	binary.LittleEndian.PutUint32(body[0:], secretsType)
+	binary.LittleEndian.PutUint32(body[4:], uint32(len(data)))
+	body = append(body, pad4(data)...)
+	writeBlock(&pw.secrets, pcapBlockSecrets, body)
+}
+
+// KeyLog returns a writer for a TLS key log, such as a tls.Config
+// KeyLogWriter. The lines written are recorded in one PcapSecretsTLSKeyLog
+// block by the next Flush.
+func (pw *PcapWriter) KeyLog() io.Writer {
+	return pcapKeyLog{pw}
+}
+
+type pcapKeyLog struct {
+	pw *PcapWriter
+}
+
+func (k pcapKeyLog) Write(p []byte) (int, error) {
+	k.pw.mu.Lock()
+	defer k.pw.mu.Unlock()
+	return k.pw.keyLog.Write(p)
+}
+
+// Flush writes the secrets and then the packets recorded since the last
+// Flush.
+func (pw *PcapWriter) Flush() error {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	if pw.keyLog.Len() > 0 {
+		pw.writeSecrets(PcapSecretsTLSKeyLog, pw.keyLog.Bytes())
+		pw.keyLog.Reset()
+	}
+	for _, b := range []*bytes.Buffer{&pw.secrets, &pw.packets} {
+		if _, err := b.WriteTo(pw.w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBlock appends a pcapng block with the given type and body, whose
+// length is a multiple of 4, to b.
+func writeBlock(b *bytes.Buffer, blockType uint32, body []byte) {
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint32(header[0:], blockType)
+	binary.LittleEndian.PutUint32(header[4:], uint32(12+len(body)))
+	b.Write(header)
+	b.Write(body)
+	b.Write(header[4:])
+}
+
+// pcapOption appends the option code with value to options.
+func pcapOption(options []byte, code uint16, value []byte) []byte {
+	header := make([]byte, 4)
+	binary.LittleEndian.PutUint16(header[0:], code)
+	binary.LittleEndian.PutUint16(header[2:], uint16(len(value)))
+	return append(append(options, header...), pad4(value)...)
+}
+
+// pad4 returns b padded with zeros to a multiple of 4 bytes.
+func pad4(b []byte) []byte {
+	if n := len(b) % 4; n != 0 {
+		return append(append([]byte(nil), b...), make([]byte, 4-n)...)
+	}
+	return b
+}
+
+// addrPort returns the IP address and port of a UDP or TCP address, the
+// unspecified IPv4 address for others.
+func addrPort(addr net.Addr) (net.IP, int) {
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		return a.IP, a.Port
+	case *net.TCPAddr:
+		return a.IP, a.Port
+	}
+	return net.IPv4zero, 0
+}
+
+// ipDatagram returns data in a UDP datagram from src to dst, with an IPv4
+// header when both addresses are IPv4 and an IPv6 header otherwise.
+func ipDatagram(src, dst net.Addr, data []byte) []byte {
+	srcIP, srcPort := addrPort(src)
+	dstIP, dstPort := addrPort(dst)
+
+	udp := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint16(udp[0:], uint16(srcPort))
+	binary.BigEndian.PutUint16(udp[2:], uint16(dstPort))
+	binary.BigEndian.PutUint16(udp[4:], uint16(len(udp)))
+	copy(udp[8:], data)
+
+	var ip, pseudo []byte
+	if src4, dst4 := srcIP.To4(), dstIP.To4(); src4 != nil && dst4 != nil {
+		ip = make([]byte, 20)
+		ip[0] = 0x45
+		binary.BigEndian.PutUint16(ip[2:], uint16(20+len(udp)))
+		binary.BigEndian.PutUint16(ip[6:], 0x4000)
+		ip[8] = 64
+		ip[9] = 17
+		copy(ip[12:], src4)
+		copy(ip[16:], dst4)
+		binary.BigEndian.PutUint16(ip[10:], checksum(ip, 0))
+		pseudo = append(append([]byte(nil), src4...), dst4...)
+	} else {
+		ip = make([]byte, 40)
+		ip[0] = 0x60
+		binary.BigEndian.PutUint16(ip[4:], uint16(len(udp)))
+		ip[6] = 17
+		ip[7] = 64
+		copy(ip[8:], srcIP.To16())
+		copy(ip[24:], dstIP.To16())
+		pseudo = append([]byte(nil), ip[8:40]...)
+	}
+	pseudo = append(pseudo, 0, 17, byte(len(udp)>>8), byte(len(udp)))
+	sum := checksum(udp, checksumAdd(pseudo, 0))
+	if sum == 0 {
+		sum = 0xffff
+	}
+	binary.BigEndian.PutUint16(udp[6:], sum)
+	return append(ip, udp...)
+}
+
+// checksumAdd adds b to the one's complement sum.
+func checksumAdd(b []byte, sum uint32) uint32 {
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	return sum
+}
+
+// checksum returns the Internet checksum of b added to sum.
+func checksum(b []byte, sum uint32) uint16 {
+	sum = checksumAdd(b, sum)
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
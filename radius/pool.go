@@ -0,0 +1,371 @@
+package radius
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ErrNoServer is returned by Pool.Exchange when the pool has no server.
+var ErrNoServer = errors.New("radius: no server in pool")
+
+// defaultDeadTime is the time a server stays dead when Pool.DeadTime is
+// zero.
+const defaultDeadTime = 30 * time.Second
+
+// statusTimeout is how long a Status-Server probe of a dead server waits
+// for its response.
+const statusTimeout = 2 * time.Second
+
+// Exchanger sends a request and returns its authentic reply. Client, Server
+// and Pool are Exchangers.
+type Exchanger interface {
+	Exchange(ctx context.Context, p *Packet) (*Packet, error)
+}
+
+// Strategy selects the order in which a Pool tries its live servers.
+type Strategy int
+
+const (
+	// StrategyOrdered tries the servers in the order they were given, the
+	// first one being the primary.
+	StrategyOrdered Strategy = iota
+	// StrategyRoundRobin starts every selection at the server following
+	// the one the previous selection started at.
+	StrategyRoundRobin
+	// StrategyWeighted starts at a server picked at random in proportion
+	// to its Weight.
+	StrategyWeighted
+)
+
+var strategyNames = map[string]Strategy{
+	"ordered":     StrategyOrdered,
+	"round-robin": StrategyRoundRobin,
+	"weighted":    StrategyWeighted,
+}
+
+// ParseStrategy returns the Strategy called name: "ordered",
+// "round-robin" or "weighted".
+func ParseStrategy(name string) (Strategy, bool) {
+	s, ok := strategyNames[name]
+	return s, ok
+}
+
+// Server is a RADIUS server of a Pool. A server that fails to answer a
+// request, after its retransmissions, is marked dead for the DeadTime of
+// the pool.
+type Server struct {
+	// Network is the transport to the server as taken by Dial, "udp" when
+	// empty.
+	Network string
+	// Addr is the host and port of the server, with the default port of
+	// Network when missing.
+	Addr   string
+	Secret string
+	// TLS is the configuration of the tls and dtls networks, whose Secret
+	// is set to their fixed one by NewPool.
+	TLS *tls.Config
+	// Weight is the share of StrategyWeighted selections starting at the
+	// server, 1 when zero.
+	Weight int
+
+	pool      *Pool
+	mu        sync.Mutex
+	client    *Client
+	dead      bool
+	deadUntil time.Time
+	// reviving is set while a revive goroutine probes the server.
+	reviving bool
+}
+
+// Dead reports whether the server is considered dead.
+func (s *Server) Dead() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.isDead()
+}
+
+func (s *Server) isDead() bool {
+	if s.dead && !s.pool.StatusServer && !time.Now().Before(s.deadUntil) {
+		s.dead = false
+	}
+	return s.dead
+}
+
+// Exchange sends p to the server with the shared Client of the server. The
+// server is marked dead when the exchange times out or its socket fails,
+// and alive when it answers.
+func (s *Server) Exchange(ctx context.Context, p *Packet) (*Packet, error) {
+	c, err := s.getClient()
+	if err != nil {
+		s.markDead(err)
+		return nil, err
+	}
+	reply, err := c.Exchange(ctx, p)
+	switch {
+	case err == nil:
+		s.mu.Lock()
+		s.dead = false
+		s.mu.Unlock()
+	case isTransportError(err):
+		s.markDead(err)
+	}
+	return reply, err
+}
+
+// getClient returns the Client of the server, replacing a failed one. The
+// replacement is dialed without holding s.mu, since a TLS or DTLS handshake
+// can take as long as the server makes it.
+func (s *Server) getClient() (*Client, error) {
+	s.mu.Lock()
+	if s.client != nil && !s.client.failed() {
+		c := s.client
+		s.mu.Unlock()
+		return c, nil
+	}
+	s.mu.Unlock()
+	c, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil && !s.client.failed() {
+		// Another exchange replaced the client first.
+		c.Close()
+		return s.client, nil
+	}
+	c.Keepalive = s.pool.Keepalive
+	if s.pool.Retransmit != nil {
+		c.Retransmit = *s.pool.Retransmit
+	}
+	c.Attempt = s.pool.Attempt
+	c.Dropped = s.pool.Dropped
+	c.Capture = s.pool.Capture
+	s.client = c
+	return c, nil
+}
+
+// dial returns a new Client for the server.
+func (s *Server) dial() (*Client, error) {
+	network := s.Network
+	if network == "" {
+		network = "udp"
+	}
+	return Dial(network, s.Addr, s.Secret, s.TLS)
+}
+
+// probe sends a Status-Server to the server.
+func (s *Server) probe() error {
+	c, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	c.Retransmit = RetransmitPolicy{}
+	ctx, cancel := context.WithTimeout(context.Background(), statusTimeout)
+	defer cancel()
+	_, err = c.Probe(ctx)
+	return err
+}
+
+// markDead marks the server dead after the failure err. With
+// Pool.StatusServer set, it is revived by the first Status-Server probe it
+// answers, sent every DeadTime.
+func (s *Server) markDead(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dead {
+		return
+	}
+	s.dead = true
+	s.deadUntil = time.Now().Add(s.pool.deadTime())
+	if s.pool.Logf != nil {
+		s.pool.Logf("radius: server %s is dead: %s", s.Addr, err)
+	}
+	if s.pool.StatusServer && !s.reviving {
+		s.reviving = true
+		go s.revive()
+	}
+}
+
+// revive probes the dead server with Status-Server until it answers, an
+// exchange finds it alive or the pool is closed.
+func (s *Server) revive() {
+	ticker := time.NewTicker(s.pool.deadTime())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.pool.done:
+			s.mu.Lock()
+			s.reviving = false
+			s.mu.Unlock()
+			return
+		case <-ticker.C:
+		}
+		s.mu.Lock()
+		if !s.dead {
+			s.reviving = false
+			s.mu.Unlock()
+			return
+		}
+		s.mu.Unlock()
+		if err := s.probe(); err != nil {
+			continue
+		}
+		s.mu.Lock()
+		s.dead = false
+		s.reviving = false
+		s.mu.Unlock()
+		if s.pool.Logf != nil {
+			s.pool.Logf("radius: server %s is alive", s.Addr)
+		}
+		return
+	}
+}
+
+// close closes the Client of the server.
+func (s *Server) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+}
+
+// Pool is a set of RADIUS servers, each with its own address and secret.
+// Exchange fails over to the next server when one does not answer.
+type Pool struct {
+	Strategy Strategy
+	// DeadTime is how long a server that stopped answering is skipped, 30
+	// seconds when zero.
+	DeadTime time.Duration
+	// StatusServer keeps dead servers dead until they answer a Status-Server
+	// probe, sent every DeadTime, instead of retrying them after DeadTime.
+	StatusServer bool
+	// Retransmit overrides DefaultRetransmitPolicy for the servers.
+	Retransmit *RetransmitPolicy
+	// Keepalive is set on the Client of every server.
+	Keepalive time.Duration
+	// Attempt and Dropped are set on the Client of every server. Like
+	// the other fields, they must be set before the pool is used; callers
+	// sharing the pool pass their own with WithHooks instead.
+	Attempt func(p *Packet, attempt int, timeout time.Duration)
+	Dropped func(data []byte, err error)
+	// Capture is set on the Client of every server.
+	Capture *PcapWriter
+	// Logf, if set, logs servers going dead and coming back.
+	Logf func(format string, v ...interface{})
+
+	servers []*Server
+	mu      sync.Mutex
+	next    int
+	done    chan struct{}
+	closing sync.Once
+}
+
+// NewPool returns a Pool of servers, the primary one first.
+func NewPool(servers ...*Server) *Pool {
+	p := &Pool{servers: servers, done: make(chan struct{})}
+	for _, s := range servers {
+		s.pool = p
+		switch s.Network {
+		case "tls":
+			s.Secret = RadSecSecret
+		case "dtls":
+			s.Secret = DTLSSecret
+		}
+	}
+	return p
+}
+
+func (p *Pool) deadTime() time.Duration {
+	if p.DeadTime > 0 {
+		return p.DeadTime
+	}
+	return defaultDeadTime
+}
+
+// Servers returns the servers of the pool in the order they were given.
+func (p *Pool) Servers() []*Server {
+	return append([]*Server(nil), p.servers...)
+}
+
+// Select returns the servers to try for a request: the live servers in the
+// order of the pool Strategy, then the dead ones as a last resort.
+func (p *Pool) Select() []*Server {
+	var alive, dead []*Server
+	for _, s := range p.servers {
+		if s.Dead() {
+			dead = append(dead, s)
+		} else {
+			alive = append(alive, s)
+		}
+	}
+	if len(alive) > 1 {
+		start := 0
+		switch p.Strategy {
+		case StrategyRoundRobin:
+			p.mu.Lock()
+			start = p.next % len(alive)
+			p.next++
+			p.mu.Unlock()
+		case StrategyWeighted:
+			start = pickWeighted(alive)
+		}
+		alive = append(append([]*Server(nil), alive[start:]...), alive[:start]...)
+	}
+	return append(alive, dead...)
+}
+
+// pickWeighted returns the index of a server picked at random in
+// proportion to its Weight.
+func pickWeighted(servers []*Server) int {
+	weight := func(s *Server) int {
+		if s.Weight > 0 {
+			return s.Weight
+		}
+		return 1
+	}
+	total := 0
+	for _, s := range servers {
+		total += weight(s)
+	}
+	n := rand.Intn(total)
+	for i, s := range servers {
+		if n -= weight(s); n < 0 {
+			return i
+		}
+	}
+	return 0
+}
+
+// Exchange sends p to the servers returned by Select until one answers.
+// Since p is encoded again for every server, attributes encrypted with a
+// secret, such as User-Password, must not be used with servers of
+// different secrets; run the whole exchange again with Server.Exchange
+// instead.
+func (p *Pool) Exchange(ctx context.Context, req *Packet) (*Packet, error) {
+	err := ErrNoServer
+	for _, s := range p.Select() {
+		var reply *Packet
+		reply, err = s.Exchange(ctx, req)
+		if err == nil || !s.Dead() {
+			return reply, err
+		}
+	}
+	return nil, err
+}
+
+// Close closes the sockets of the servers and stops reviving dead ones.
+func (p *Pool) Close() error {
+	p.closing.Do(func() { close(p.done) })
+	for _, s := range p.servers {
+		s.close()
+	}
+	return nil
+}
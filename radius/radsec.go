@@ -0,0 +1,62 @@
+package radius
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+)
+
+const (
+	// RadSecPort is the port of RFC 6614 RADIUS over TLS servers.
+	RadSecPort = "2083"
+	// RadSecSecret is the shared secret RFC 6614 requires over TLS.
+	RadSecSecret = "radsec"
+)
+
+// NewTLSClient returns a Client exchanging packets with the RadSec server
+// at addr, port 2083 if it has none, over one TLS connection. config must
+// hold the client certificate, since RFC 6614 requires mutual
+// authentication, and the CAs to verify the server with. The packets are
+// framed by their Length field and signed with the "radsec" secret.
+// Requests are not retransmitted: an exchange waits for the MRD of the
+// Retransmit policy. The connection is dialed again when it closes; set
+// Keepalive to watch it with Status-Server.
+func NewTLSClient(addr string, config *tls.Config) (*Client, error) {
+	if len(config.Certificates) == 0 && config.GetClientCertificate == nil {
+		return nil, errors.New("radius: RadSec needs a client certificate")
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, RadSecPort)
+	}
+	return newStreamClient(func() (net.Conn, error) {
+		return tls.Dial("tcp", addr, config)
+	}, RadSecSecret)
+}
+
+// NewTLSConfig returns a TLS configuration authenticating with the
+// certificate and key in the PEM files certFile and keyFile, and verifying
+// the server with the CAs in caFile, or the system ones when caFile is
+// empty.
+func NewTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("radius: no certificate in " + caFile)
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,54 @@
+package radius
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ErrTimeout is returned by Exchange when the server did not answer any
+// transmission of a request.
+var ErrTimeout = errors.New("radius: no reply from server")
+
+// RetransmitPolicy holds the RFC 5080 section 2.2.1 retransmission
+// parameters of a Client. A request is sent again, with the same Identifier
+// and authenticator, when no reply arrived within the retransmission timeout
+// RT. RT starts at IRT and doubles after every transmission, up to MRT, with
+// a random factor of +/- 10%. The exchange fails after MRC retransmissions
+// or when MRD has elapsed, whichever comes first. A zero IRT disables
+// retransmission and timeouts; zero MRC, MRT or MRD values mean no limit.
+type RetransmitPolicy struct {
+	IRT time.Duration // initial retransmission time
+	MRC int           // maximum retransmission count
+	MRT time.Duration // maximum retransmission time
+	MRD time.Duration // maximum retransmission duration
+}
+
+// DefaultRetransmitPolicy holds the defaults of RFC 5080 for clients that
+// are not proxies.
+var DefaultRetransmitPolicy = RetransmitPolicy{
+	IRT: 2 * time.Second,
+	MRC: 5,
+	MRT: 16 * time.Second,
+	MRD: 30 * time.Second,
+}
+
+// randomized returns base plus RAND times d, RAND being the RFC 5080 random
+// factor between -0.1 and +0.1.
+func randomized(base, d time.Duration) time.Duration {
+	return base + time.Duration((rand.Float64()*0.2-0.1)*float64(d))
+}
+
+// timeout returns the retransmission timeout following prev, or the initial
+// one when prev is zero: RT = 2*RTprev + RAND*RTprev, and RT = MRT + RAND*MRT
+// once above MRT.
+func (r RetransmitPolicy) timeout(prev time.Duration) time.Duration {
+	if prev == 0 {
+		return randomized(r.IRT, r.IRT)
+	}
+	rt := randomized(2*prev, prev)
+	if r.MRT > 0 && rt > r.MRT {
+		rt = randomized(r.MRT, r.MRT)
+	}
+	return rt
+}
@@ -0,0 +1,120 @@
+package radius
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"errors"
+)
+
+// EncryptSalted encrypts value with the salted MD5 scheme of RFC 2548 section
+// 2.4.2 and RFC 2868 section 3.5, used by MS-MPPE-Send-Key,
+// MS-MPPE-Recv-Key and Tunnel-Password. The result is the two byte salt
+// followed by the encrypted length prefixed and padded value.
+func EncryptSalted(value []byte, secret string, authenticator [16]byte) ([]byte, error) {
+	if len(value) > 239 {
+		return nil, errors.New("radius: salted value too long")
+	}
+	salt := make([]byte, 2)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	salt[0] |= 0x80
+
+	size := (len(value) + 1 + 15) / 16 * 16
+	enc := make([]byte, 2+size)
+	copy(enc, salt)
+	enc[2] = byte(len(value))
+	copy(enc[3:], value)
+
+	last := append(authenticator[:], salt...)
+	for i := 2; i < len(enc); i += 16 {
+		h := md5.New()
+		h.Write([]byte(secret))
+		h.Write(last)
+		b := h.Sum(nil)
+		for j := 0; j < 16; j++ {
+			enc[i+j] ^= b[j]
+		}
+		last = enc[i : i+16]
+	}
+	return enc, nil
+}
+
+// DecryptSalted reverses EncryptSalted. authenticator is the Request
+// Authenticator of the Access-Request the value answers.
+func DecryptSalted(value []byte, secret string, authenticator [16]byte) ([]byte, error) {
+	if len(value) < 18 || (len(value)-2)%16 != 0 {
+		return nil, errors.New("radius: invalid salted value length")
+	}
+	if value[0]&0x80 == 0 {
+		return nil, errors.New("radius: invalid salt")
+	}
+	dec := make([]byte, len(value)-2)
+
+	last := append(authenticator[:], value[:2]...)
+	for i := 2; i < len(value); i += 16 {
+		h := md5.New()
+		h.Write([]byte(secret))
+		h.Write(last)
+		b := h.Sum(nil)
+		for j := 0; j < 16; j++ {
+			dec[i-2+j] = value[i+j] ^ b[j]
+		}
+		last = value[i : i+16]
+	}
+	length := int(dec[0])
+	if length > len(dec)-1 {
+		return nil, errors.New("radius: invalid salted value, check the shared secret")
+	}
+	return dec[1 : 1+length], nil
+}
+
+// TunnelPassword_Get decrypts the first Tunnel-Password of p, a reply to
+// req, and returns its tag and clear text.
+func (p *Packet) TunnelPassword_Get(req *Packet, secret string) (tag byte, value []byte, err error) {
+	a, ok := p.Lookup(TunnelPassword_Type)
+	if !ok {
+		err = ErrNoAttribute
+		return
+	}
+	if len(a) < 1 {
+		err = errors.New("radius: invalid Tunnel-Password length")
+		return
+	}
+	tag = a[0]
+	value, err = DecryptSalted(a[1:], secret, req.Authenticator)
+	return
+}
+
+// TunnelPassword_Set encrypts password with the Authenticator of p and sets it
+// as Tunnel-Password with the given tag.
+func (p *Packet) TunnelPassword_Set(tag byte, password []byte, secret string) (err error) {
+	enc, err := EncryptSalted(password, secret, p.Authenticator)
+	if err != nil {
+		return
+	}
+	a, err := NewBytes(append([]byte{tag}, enc...))
+	if err != nil {
+		return
+	}
+	p.Set(TunnelPassword_Type, a)
+	return
+}
+
+// MSMPPESendKey_Get decrypts the MS-MPPE-Send-Key of p, a reply to req.
+func (p *Packet) MSMPPESendKey_Get(req *Packet, secret string) ([]byte, error) {
+	return p.msMPPEKey(MSMPPESendKey_VendorType, req, secret)
+}
+
+// MSMPPERecvKey_Get decrypts the MS-MPPE-Recv-Key of p, a reply to req.
+func (p *Packet) MSMPPERecvKey_Get(req *Packet, secret string) ([]byte, error) {
+	return p.msMPPEKey(MSMPPERecvKey_VendorType, req, secret)
+}
+
+func (p *Packet) msMPPEKey(vendorType uint32, req *Packet, secret string) ([]byte, error) {
+	a, err := p.VSA_Get(VendorMicrosoft, vendorType)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptSalted(a, secret, req.Authenticator)
+}
@@ -0,0 +1,104 @@
+package radius
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"net"
+	"time"
+)
+
+// AuthPort is the port of RADIUS authentication servers.
+const AuthPort = "1812"
+
+// NewStatusServer creates a RFC 5997 Status-Server request signed with a
+// Message-Authenticator, which section 3 of the RFC requires.
+func NewStatusServer(secret string) (*Packet, error) {
+	b := make([]byte, 1+16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	p := &Packet{Code: CodeStatusServer, Identifier: b[0]}
+	copy(p.Authenticator[:], b[1:])
+	if err := p.MessageAuthenticator_Prepend(secret); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// VerifyStatusResponse checks that p is an authentic answer to the
+// Status-Server req: an Access-Accept from an authentication server or an
+// Accounting-Response from an accounting server. The Message-Authenticator
+// of an Access-Accept is mandatory.
+func (p *Packet) VerifyStatusResponse(req *Packet, secret string) error {
+	if err := p.VerifyResponse(req, secret); err != nil {
+		return err
+	}
+	if _, ok := p.Lookup(MessageAuthenticator_Type); ok {
+		if err := p.MessageAuthenticator_Verify(req, secret); err != nil {
+			return err
+		}
+	}
+	return checkStatusResponse(p, req)
+}
+
+// checkStatusResponse checks the code of p, a reply to the Status-Server
+// req whose authenticators were verified, and that an Access-Accept carries
+// a Message-Authenticator.
+func checkStatusResponse(p, req *Packet) error {
+	switch p.Code {
+	case CodeAccessAccept:
+		if _, ok := p.Lookup(MessageAuthenticator_Type); !ok {
+			return ErrNoAttribute
+		}
+		return nil
+	case CodeAccountingResponse:
+		return nil
+	}
+	return errors.New("radius: unexpected " + p.Code.String() + " to Status-Server")
+}
+
+// ProbeResult is the outcome of a successful Probe.
+type ProbeResult struct {
+	RTT   time.Duration
+	Reply *Packet
+}
+
+// Probe sends a Status-Server to the server at addr, port 1812 if it has
+// none, and waits up to timeout for its authentic response. The reply
+// carries the attributes the server supplied, if any.
+func Probe(addr, secret string, timeout time.Duration) (*ProbeResult, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, AuthPort)
+	}
+	c, err := NewClient(addr, secret)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	// The Status-Server is sent once and awaited until the timeout.
+	c.Retransmit = RetransmitPolicy{}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.Probe(ctx)
+}
+
+// Probe sends a Status-Server with c and waits for its authentic response
+// until ctx is done, dropping the replies that are not a valid answer.
+func (c *Client) Probe(ctx context.Context) (*ProbeResult, error) {
+	req, err := NewStatusServer(c.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if c.stream {
+		// RFC 7930: announce the largest reply accepted.
+		req.ResponseLength_Set(uint32(c.maxLength))
+	}
+	ctx = WithHooks(ctx, &Hooks{Verify: checkStatusResponse})
+	start := time.Now()
+	reply, err := c.Exchange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ProbeResult{RTT: time.Since(start), Reply: reply}, nil
+}
@@ -0,0 +1,119 @@
+package radius
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/pion/dtls/v2"
+)
+
+const (
+	// DTLSPort is the port of RFC 7360 RADIUS over DTLS servers.
+	DTLSPort = "2083"
+	// DTLSSecret is the shared secret RFC 7360 requires over DTLS.
+	DTLSSecret = "radius/dtls"
+)
+
+// dtlsHandshakeTimeout bounds the DTLS handshake of NewDTLSClient.
+const dtlsHandshakeTimeout = 10 * time.Second
+
+// Dial returns a Client for the server at addr over network:
+//
+//	"udp"   RADIUS over UDP (RFC 2865), port 1812 by default
+//	"tcp"   RADIUS over TCP (RFC 6613), port 1812 by default
+//	"tls"   RadSec (RFC 6614), port 2083 by default
+//	"dtls"  RADIUS over DTLS (RFC 7360), port 2083 by default
+//
+// config holds the certificates of tls and dtls, whose fixed secrets
+// replace secret, and is ignored otherwise.
+func Dial(network, addr, secret string, config *tls.Config) (*Client, error) {
+	switch network {
+	case "udp", "tcp":
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, AuthPort)
+		}
+		if network == "udp" {
+			return NewClient(addr, secret)
+		}
+		return NewTCPClient(addr, secret)
+	case "tls", "dtls":
+		if config == nil {
+			return nil, errors.New("radius: " + network + " needs a TLS config")
+		}
+		if network == "tls" {
+			return NewTLSClient(addr, config)
+		}
+		return NewDTLSClient(addr, config)
+	}
+	return nil, errors.New("radius: unknown network " + network)
+}
+
+// NewTCPClient returns a Client exchanging packets with the server at addr
+// over one RFC 6613 TCP connection. The packets are framed by their Length
+// field. Requests are not retransmitted: an exchange waits for the MRD of
+// the Retransmit policy. The connection is dialed again when it closes.
+func NewTCPClient(addr, secret string) (*Client, error) {
+	return newStreamClient(func() (net.Conn, error) {
+		return net.Dial("tcp", addr)
+	}, secret)
+}
+
+// newStreamClient returns a Client over the stream connections of dial.
+// RFC 7930 packets of up to 65535 bytes are allowed on streams.
+func newStreamClient(dial func() (net.Conn, error), secret string) (*Client, error) {
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	c := newClient(conn, secret)
+	c.dial = dial
+	c.stream = true
+	c.maxLength = MaxStreamPacketLength
+	return c, nil
+}
+
+// NewDTLSClient returns a Client exchanging packets with the RFC 7360
+// server at addr, port 2083 if it has none, over one DTLS association.
+// Like NewTLSClient, config must hold a client certificate. The packets
+// are signed with the "radius/dtls" secret and retransmitted as over UDP.
+// The association is established again when it fails.
+func NewDTLSClient(addr string, config *tls.Config) (*Client, error) {
+	if len(config.Certificates) == 0 {
+		return nil, errors.New("radius: DTLS needs a client certificate")
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host, addr = addr, net.JoinHostPort(addr, DTLSPort)
+	}
+	serverName := config.ServerName
+	if serverName == "" {
+		serverName = host
+	}
+	dtlsConfig := &dtls.Config{
+		Certificates:         config.Certificates,
+		RootCAs:              config.RootCAs,
+		ServerName:           serverName,
+		InsecureSkipVerify:   config.InsecureSkipVerify,
+		ExtendedMasterSecret: dtls.RequireExtendedMasterSecret,
+		ConnectContextMaker: func() (context.Context, func()) {
+			return context.WithTimeout(context.Background(), dtlsHandshakeTimeout)
+		},
+	}
+	dial := func() (net.Conn, error) {
+		raddr, err := net.ResolveUDPAddr("udp", addr)
+		if err != nil {
+			return nil, err
+		}
+		return dtls.Dial("udp", raddr, dtlsConfig)
+	}
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	c := newClient(conn, DTLSSecret)
+	c.dial = dial
+	return c, nil
+}
@@ -0,0 +1,373 @@
+package radius
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"net"
+	"time"
+)
+
+var ErrNoAttribute = errors.New("radius: attribute not found")
+
+// NewInteger creates a new Attribute from the given integer value.
+func NewInteger(i uint32) Attribute {
+	v := make([]byte, 4)
+	binary.BigEndian.PutUint32(v, i)
+	return v
+}
+
+// NewString returns a new Attribute from the given string. An error is returned
+// if the string length is greater than 253.
+func NewString(s string) (Attribute, error) {
+	if len(s) > 253 {
+		return nil, errors.New("string too long")
+	}
+	return Attribute(s), nil
+}
+
+// Bytes returns the given Attribute as a byte slice.
+func Bytes(a Attribute) []byte {
+	b := make([]byte, len(a))
+	copy(b, a)
+	return b
+}
+
+// NewBytes returns a new Attribute from the given byte slice. An error is
+// returned if the slice is longer than 253.
+func NewBytes(b []byte) (Attribute, error) {
+	if len(b) > 253 {
+		return nil, errors.New("value too long")
+	}
+	a := make(Attribute, len(b))
+	copy(a, b)
+	return a, nil
+}
+
+// NewIPAddr returns a new Attribute from the given IP address. An error is
+// returned if the given address is not an IPv4 address.
+func NewIPAddr(a net.IP) (Attribute, error) {
+	a = a.To4()
+	if a == nil {
+		return nil, errors.New("invalid IPv4 address")
+	}
+	b := make(Attribute, len(a))
+	copy(b, a)
+	return b, nil
+}
+
+// String returns the given Attribute as a string.
+func String(a Attribute) string {
+	return string(a)
+}
+
+// Integer returns the given Attribute as an integer. An error is returned if
+// the attribute is not 4 bytes long.
+func Integer(a Attribute) (uint32, error) {
+	if len(a) != 4 {
+		return 0, errors.New("invalid integer length")
+	}
+	return binary.BigEndian.Uint32(a), nil
+}
+
+// IPAddr returns the given Attribute as an IPv4 address. An error is returned
+// if the attribute is not 4 bytes long.
+func IPAddr(a Attribute) (net.IP, error) {
+	if len(a) != net.IPv4len {
+		return nil, errors.New("invalid IPv4 address length")
+	}
+	b := make(net.IP, len(a))
+	copy(b, a)
+	return b, nil
+}
+
+// SplitTag splits the RFC 2868 tag off a tagged attribute value. Integer
+// values always carry the tag in their first byte; other values carry one
+// only when the first byte is in the range 0x01-0x1F.
+func SplitTag(a Attribute, integer bool) (tag byte, value Attribute) {
+	if integer {
+		if len(a) != 4 {
+			return 0, a
+		}
+		return a[0], Attribute{0, a[1], a[2], a[3]}
+	}
+	if len(a) > 0 && a[0] <= 0x1f {
+		return a[0], a[1:]
+	}
+	return 0, a
+}
+
+// JoinTag prefixes value with an RFC 2868 tag, the reverse of SplitTag.
+func JoinTag(tag byte, value Attribute, integer bool) (Attribute, error) {
+	if tag > 0x1f {
+		return nil, errors.New("invalid tag")
+	}
+	if integer {
+		if len(value) != 4 {
+			return nil, errors.New("invalid integer length")
+		}
+		if value[0] != 0 {
+			return nil, errors.New("tagged integer out of range")
+		}
+		return Attribute{tag, value[1], value[2], value[3]}, nil
+	}
+	if tag == 0 && (len(value) == 0 || value[0] > 0x1f) {
+		return value, nil
+	}
+	if len(value) > 252 {
+		return nil, errors.New("value too long")
+	}
+	return append(Attribute{tag}, value...), nil
+}
+
+// NewDate returns a new date Attribute holding t in seconds since the Unix
+// epoch. An error is returned if t does not fit in 32 bits.
+func NewDate(t time.Time) (Attribute, error) {
+	sec := t.Unix()
+	if sec < 0 || sec > math.MaxUint32 {
+		return nil, errors.New("date out of range")
+	}
+	return NewInteger(uint32(sec)), nil
+}
+
+// Date returns the given date Attribute as a time. An error is returned if
+// the attribute is not 4 bytes long.
+func Date(a Attribute) (time.Time, error) {
+	if len(a) != 4 {
+		return time.Time{}, errors.New("invalid date length")
+	}
+	return time.Unix(int64(binary.BigEndian.Uint32(a)), 0), nil
+}
+
+// NewIPv6Addr returns a new Attribute from the given IP address. An error is
+// returned if the given address is not an IPv6 address.
+func NewIPv6Addr(a net.IP) (Attribute, error) {
+	if len(a) != net.IPv6len || a.To4() != nil {
+		return nil, errors.New("invalid IPv6 address")
+	}
+	b := make(Attribute, len(a))
+	copy(b, a)
+	return b, nil
+}
+
+// IPv6Addr returns the given Attribute as an IPv6 address. An error is
+// returned if the attribute is not 16 bytes long.
+func IPv6Addr(a Attribute) (net.IP, error) {
+	if len(a) != net.IPv6len {
+		return nil, errors.New("invalid IPv6 address length")
+	}
+	b := make(net.IP, len(a))
+	copy(b, a)
+	return b, nil
+}
+
+// NewComboIP returns a new combo-ip Attribute, holding either an IPv4 or an
+// IPv6 address.
+func NewComboIP(a net.IP) (Attribute, error) {
+	if ip := a.To4(); ip != nil {
+		return NewIPAddr(ip)
+	}
+	return NewIPv6Addr(a)
+}
+
+// ComboIP returns the given combo-ip Attribute as an IP address. An error is
+// returned if the attribute is neither 4 nor 16 bytes long.
+func ComboIP(a Attribute) (net.IP, error) {
+	if len(a) == net.IPv4len {
+		return IPAddr(a)
+	}
+	if len(a) == net.IPv6len {
+		return IPv6Addr(a)
+	}
+	return nil, errors.New("invalid combo-ip length")
+}
+
+// NewIPv6Prefix returns a new Attribute from the given IPv6 prefix. Only the
+// significant bytes of the prefix are encoded.
+func NewIPv6Prefix(prefix *net.IPNet) (Attribute, error) {
+	ones, bits := prefix.Mask.Size()
+	if bits != 8*net.IPv6len || len(prefix.IP) != net.IPv6len || prefix.IP.To4() != nil {
+		return nil, errors.New("invalid IPv6 prefix")
+	}
+	ip := prefix.IP.Mask(prefix.Mask)
+	b := make(Attribute, 2+(ones+7)/8)
+	b[1] = byte(ones)
+	copy(b[2:], ip)
+	return b, nil
+}
+
+// IPv6Prefix returns the given Attribute as an IPv6 prefix. An error is
+// returned if the attribute is shorter than its prefix length or has bits
+// set outside of the prefix.
+func IPv6Prefix(a Attribute) (*net.IPNet, error) {
+	if len(a) < 2 || len(a) > 2+net.IPv6len {
+		return nil, errors.New("invalid IPv6 prefix length")
+	}
+	ones := int(a[1])
+	if ones > 8*net.IPv6len || len(a)-2 < (ones+7)/8 {
+		return nil, errors.New("invalid IPv6 prefix length")
+	}
+	prefix := &net.IPNet{
+		IP:   make(net.IP, net.IPv6len),
+		Mask: net.CIDRMask(ones, 8*net.IPv6len),
+	}
+	copy(prefix.IP, a[2:])
+	if !prefix.IP.Mask(prefix.Mask).Equal(prefix.IP) {
+		return nil, errors.New("IPv6 prefix has bits set outside of the prefix")
+	}
+	return prefix, nil
+}
+
+// NewIPv4Prefix returns a new Attribute from the given IPv4 prefix.
+func NewIPv4Prefix(prefix *net.IPNet) (Attribute, error) {
+	ones, bits := prefix.Mask.Size()
+	ip := prefix.IP.To4()
+	if bits != 8*net.IPv4len || ip == nil {
+		return nil, errors.New("invalid IPv4 prefix")
+	}
+	b := make(Attribute, 2+net.IPv4len)
+	b[1] = byte(ones)
+	copy(b[2:], ip.Mask(prefix.Mask))
+	return b, nil
+}
+
+// IPv4Prefix returns the given Attribute as an IPv4 prefix. An error is
+// returned if the attribute is not 6 bytes long or has bits set outside of
+// the prefix.
+func IPv4Prefix(a Attribute) (*net.IPNet, error) {
+	if len(a) != 2+net.IPv4len || a[1] > 8*net.IPv4len {
+		return nil, errors.New("invalid IPv4 prefix length")
+	}
+	prefix := &net.IPNet{
+		IP:   net.IP(Bytes(a[2:])),
+		Mask: net.CIDRMask(int(a[1]), 8*net.IPv4len),
+	}
+	if !prefix.IP.Mask(prefix.Mask).Equal(prefix.IP) {
+		return nil, errors.New("IPv4 prefix has bits set outside of the prefix")
+	}
+	return prefix, nil
+}
+
+// NewIfID returns a new Attribute from the given 8 byte interface
+// identifier.
+func NewIfID(id []byte) (Attribute, error) {
+	if len(id) != 8 {
+		return nil, errors.New("invalid interface identifier")
+	}
+	return NewBytes(id)
+}
+
+// IfID returns the given Attribute as an interface identifier. An error is
+// returned if the attribute is not 8 bytes long.
+func IfID(a Attribute) ([]byte, error) {
+	if len(a) != 8 {
+		return nil, errors.New("invalid interface identifier length")
+	}
+	return Bytes(a), nil
+}
+
+// NewByte creates a new Attribute from the given byte value.
+func NewByte(i uint8) Attribute {
+	return Attribute{i}
+}
+
+// Byte returns the given Attribute as a byte value. An error is returned if
+// the attribute is not 1 byte long.
+func Byte(a Attribute) (uint8, error) {
+	if len(a) != 1 {
+		return 0, errors.New("invalid byte length")
+	}
+	return a[0], nil
+}
+
+// NewShort creates a new Attribute from the given 16 bit integer value.
+func NewShort(i uint16) Attribute {
+	v := make([]byte, 2)
+	binary.BigEndian.PutUint16(v, i)
+	return v
+}
+
+// Short returns the given Attribute as a 16 bit integer. An error is
+// returned if the attribute is not 2 bytes long.
+func Short(a Attribute) (uint16, error) {
+	if len(a) != 2 {
+		return 0, errors.New("invalid short length")
+	}
+	return binary.BigEndian.Uint16(a), nil
+}
+
+// NewInteger64 creates a new Attribute from the given 64 bit integer value.
+func NewInteger64(i uint64) Attribute {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, i)
+	return v
+}
+
+// Integer64 returns the given Attribute as a 64 bit integer. An error is
+// returned if the attribute is not 8 bytes long.
+func Integer64(a Attribute) (uint64, error) {
+	if len(a) != 8 {
+		return 0, errors.New("invalid integer64 length")
+	}
+	return binary.BigEndian.Uint64(a), nil
+}
+
+// NewSigned creates a new Attribute from the given signed integer value.
+func NewSigned(i int32) Attribute {
+	return NewInteger(uint32(i))
+}
+
+// Signed returns the given Attribute as a signed integer. An error is
+// returned if the attribute is not 4 bytes long.
+func Signed(a Attribute) (int32, error) {
+	i, err := Integer(a)
+	if err != nil {
+		return 0, errors.New("invalid signed length")
+	}
+	return int32(i), nil
+}
+
+// TLV is a Type-Length-Value element of an RFC 6929 tlv attribute.
+type TLV struct {
+	Type  byte
+	Value Attribute
+}
+
+// NewTLV encodes tlvs as the value of a tlv attribute. An error is returned
+// if a value is empty, which RFC 6929 does not allow, or longer than 253
+// bytes.
+func NewTLV(tlvs ...*TLV) (Attribute, error) {
+	var b Attribute
+	for _, tlv := range tlvs {
+		if len(tlv.Value) == 0 {
+			return nil, errors.New("empty TLV value")
+		}
+		if len(tlv.Value) > 253 {
+			return nil, errors.New("TLV value too long")
+		}
+		b = append(b, tlv.Type, byte(2+len(tlv.Value)))
+		b = append(b, tlv.Value...)
+	}
+	if len(b) > 253 {
+		return nil, errors.New("value too long")
+	}
+	return b, nil
+}
+
+// ParseTLV decodes the value of a tlv attribute. An error is returned if an
+// element length is invalid.
+func ParseTLV(a Attribute) ([]*TLV, error) {
+	var tlvs []*TLV
+	for len(a) > 0 {
+		if len(a) < 2 {
+			return nil, errors.New("short TLV")
+		}
+		length := int(a[1])
+		if length < 3 || length > len(a) {
+			return nil, errors.New("invalid TLV length")
+		}
+		tlvs = append(tlvs, &TLV{Type: a[0], Value: Bytes(a[2:length])})
+		a = a[length:]
+	}
+	return tlvs, nil
+}
@@ -0,0 +1,226 @@
+package radius
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+)
+
+// Identifiers of vendors with a non default sub-attribute format that have
+// no dictionary in the package.
+const (
+	VendorLucent  uint32 = 4846
+	VendorStarent uint32 = 8164
+)
+
+// VendorFormat gives the size in bytes of the vendor-type and vendor-length
+// fields of a vendor's sub-attributes. A LengthSize of 0 means the value
+// extends to the end of the Vendor-Specific attribute.
+type VendorFormat struct {
+	TypeSize   int
+	LengthSize int
+}
+
+// DefaultVendorFormat is the RFC 2865 recommended 1 byte type, 1 byte length
+// format.
+var DefaultVendorFormat = VendorFormat{TypeSize: 1, LengthSize: 1}
+
+var (
+	vendorFormatsMu sync.RWMutex
+	vendorFormats   = map[uint32]VendorFormat{
+		VendorUSR:     {TypeSize: 4, LengthSize: 0},
+		VendorLucent:  {TypeSize: 2, LengthSize: 1},
+		VendorStarent: {TypeSize: 2, LengthSize: 2},
+	}
+)
+
+// RegisterVendorFormat sets the sub-attribute format used for vendor when
+// it is missing from the dictionary in use.
+func RegisterVendorFormat(vendor uint32, format VendorFormat) {
+	vendorFormatsMu.Lock()
+	defer vendorFormatsMu.Unlock()
+	vendorFormats[vendor] = format
+}
+
+// LookupVendorFormat returns the registered sub-attribute format of vendor.
+func LookupVendorFormat(vendor uint32) VendorFormat {
+	vendorFormatsMu.RLock()
+	defer vendorFormatsMu.RUnlock()
+	if format, ok := vendorFormats[vendor]; ok {
+		return format
+	}
+	return DefaultVendorFormat
+}
+
+// VSA is a decoded Vendor-Specific sub-attribute.
+type VSA struct {
+	Vendor uint32
+	Type   uint32
+	Value  Attribute
+}
+
+// VendorFormat returns the sub-attribute format of vendor: the format of its
+// VENDOR definition in d, or its registered format.
+func (d *Dictionary) VendorFormat(vendor uint32) VendorFormat {
+	if v, ok := d.vendorByID[vendor]; ok {
+		return v.Format
+	}
+	return LookupVendorFormat(vendor)
+}
+
+// NewVSA encodes a Vendor-Specific attribute value holding one
+// sub-attribute, using the vendor formats of DefaultDictionary.
+func NewVSA(vendor, vendorType uint32, value []byte) (Attribute, error) {
+	return DefaultDictionary().NewVSA(vendor, vendorType, value)
+}
+
+// NewVSA is like the NewVSA function but uses the vendor formats of d.
+func (d *Dictionary) NewVSA(vendor, vendorType uint32, value []byte) (Attribute, error) {
+	format := d.VendorFormat(vendor)
+	size := 4 + format.TypeSize + format.LengthSize + len(value)
+	if size > 253 {
+		return nil, errors.New("radius: vendor attribute too long")
+	}
+	if format.TypeSize < 4 && vendorType >= 1<<(8*uint(format.TypeSize)) {
+		return nil, errors.New("radius: vendor type out of range")
+	}
+	a := make(Attribute, size)
+	binary.BigEndian.PutUint32(a, vendor)
+	b := a[4:]
+	putUint(b[:format.TypeSize], vendorType)
+	putUint(b[format.TypeSize:format.TypeSize+format.LengthSize], uint32(len(b)))
+	copy(b[format.TypeSize+format.LengthSize:], value)
+	return a, nil
+}
+
+// ParseVSA decodes all sub-attributes of a Vendor-Specific attribute value,
+// using the vendor formats of DefaultDictionary.
+func ParseVSA(a Attribute) ([]*VSA, error) {
+	return DefaultDictionary().ParseVSA(a)
+}
+
+// ParseVSA is like the ParseVSA function but uses the vendor formats of d.
+func (d *Dictionary) ParseVSA(a Attribute) ([]*VSA, error) {
+	if len(a) < 4 {
+		return nil, errors.New("radius: short Vendor-Specific attribute")
+	}
+	vendor := binary.BigEndian.Uint32(a)
+	format := d.VendorFormat(vendor)
+	header := format.TypeSize + format.LengthSize
+
+	var vsas []*VSA
+	b := a[4:]
+	for len(b) > 0 {
+		if len(b) < header {
+			return nil, errors.New("radius: short vendor attribute")
+		}
+		length := len(b)
+		if format.LengthSize > 0 {
+			length = int(getUint(b[format.TypeSize:header]))
+			if length < header || length > len(b) {
+				return nil, errors.New("radius: invalid vendor attribute length")
+			}
+		}
+		vsas = append(vsas, &VSA{
+			Vendor: vendor,
+			Type:   getUint(b[:format.TypeSize]),
+			Value:  append(Attribute(nil), b[header:length]...),
+		})
+		b = b[length:]
+	}
+	return vsas, nil
+}
+
+// VSAs returns every Vendor-Specific sub-attribute of p in order.
+func (p *Packet) VSAs() (vsas []*VSA, err error) {
+	for _, avp := range p.Attributes {
+		if avp.Type != VendorSpecific_Type {
+			continue
+		}
+		var v []*VSA
+		v, err = ParseVSA(avp.Attribute)
+		if err != nil {
+			return
+		}
+		vsas = append(vsas, v...)
+	}
+	return
+}
+
+// VSA_Add appends a Vendor-Specific attribute to p.
+func (p *Packet) VSA_Add(vendor, vendorType uint32, value []byte) (err error) {
+	a, err := NewVSA(vendor, vendorType, value)
+	if err != nil {
+		return
+	}
+	p.Add(VendorSpecific_Type, a)
+	return
+}
+
+// VSA_Gets returns the values of all vendorType sub-attributes of vendor.
+func (p *Packet) VSA_Gets(vendor, vendorType uint32) (values []Attribute, err error) {
+	vsas, err := p.VSAs()
+	if err != nil {
+		return
+	}
+	for _, v := range vsas {
+		if v.Vendor == vendor && v.Type == vendorType {
+			values = append(values, v.Value)
+		}
+	}
+	return
+}
+
+// VSA_Get returns the value of the first vendorType sub-attribute of vendor.
+func (p *Packet) VSA_Get(vendor, vendorType uint32) (value Attribute, err error) {
+	values, err := p.VSA_Gets(vendor, vendorType)
+	if err != nil {
+		return
+	}
+	if len(values) == 0 {
+		err = ErrNoAttribute
+		return
+	}
+	value = values[0]
+	return
+}
+
+// VSA_Del removes the Vendor-Specific attributes holding a vendorType
+// sub-attribute of vendor.
+func (p *Packet) VSA_Del(vendor, vendorType uint32) {
+	for i := 0; i < len(p.Attributes); {
+		avp := p.Attributes[i]
+		if avp.Type == VendorSpecific_Type && vsaContains(avp.Attribute, vendor, vendorType) {
+			p.Attributes = append(p.Attributes[:i], p.Attributes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+}
+
+func vsaContains(a Attribute, vendor, vendorType uint32) bool {
+	vsas, err := ParseVSA(a)
+	if err != nil {
+		return false
+	}
+	for _, v := range vsas {
+		if v.Vendor == vendor && v.Type == vendorType {
+			return true
+		}
+	}
+	return false
+}
+
+func putUint(b []byte, v uint32) {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+}
+
+func getUint(b []byte) (v uint32) {
+	for _, c := range b {
+		v = v<<8 | uint32(c)
+	}
+	return
+}
@@ -0,0 +1,217 @@
+package session
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/sdir/eapol_test/radius"
+)
+
+// Counters are the traffic counters of a session reported in
+// Interim-Update and Stop. Values above 32 bits are sent with the
+// Acct-Input-Gigawords and Acct-Output-Gigawords attributes.
+type Counters struct {
+	InputOctets   uint64
+	OutputOctets  uint64
+	InputPackets  uint64
+	OutputPackets uint64
+}
+
+// syntheticPacketSize is the average packet size used to derive the packet
+// counters from the synthetic traffic.
+const syntheticPacketSize = 512
+
+// AccountingOn sends an Accounting-On, telling the server that the NAS
+// (re)started and that its previous sessions are over.
+func (s *Session) AccountingOn() error {
+	packet := radius.NewAccountingRequest()
+	for _, err := range []error{
+		packet.AcctStatusType_Set(radius.AcctStatusType_Value_AccountingOn),
+		addAddresses(s.context.NasAddr, packet.NASIPAddress_Add, packet.NASIPv6Address_Add),
+		packet.EventTimestamp_Set(time.Now()),
+	} {
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	return s.sendAccounting(packet)
+}
+
+// AccountingStart sends the Start of the session, generating its
+// Acct-Session-Id if none is set.
+func (s *Session) AccountingStart() error {
+	if s.AcctSessionID == "" {
+		id := make([]byte, 8)
+		if _, err := rand.Read(id); err != nil {
+			return err
+		}
+		s.AcctSessionID = hex.EncodeToString(id)
+	}
+	s.acctStart = time.Now()
+	return s.sendAccounting(s.accountingRequest(radius.AcctStatusType_Value_Start))
+}
+
+// AccountingInterim sends an Interim-Update with the current Counters.
+func (s *Session) AccountingInterim() error {
+	return s.sendAccounting(s.accountingRequest(radius.AcctStatusType_Value_InterimUpdate))
+}
+
+// AccountingStop sends the Stop of the session with the final Counters.
+func (s *Session) AccountingStop(cause radius.AcctTerminateCause) error {
+	packet := s.accountingRequest(radius.AcctStatusType_Value_Stop)
+	if err := packet.AcctTerminateCause_Set(cause); err != nil {
+		return err
+	}
+	return s.sendAccounting(packet)
+}
+
+// accountingRequest builds an Accounting-Request of the session with the
+// given Acct-Status-Type. Class attributes of the Access-Accept are echoed
+// as RFC 2865 section 5.25 requires.
+func (s *Session) accountingRequest(status radius.AcctStatusType) *radius.Packet {
+	packet := radius.NewAccountingRequest()
+
+	s.setNasAttributes(packet)
+	errs := []error{
+		packet.AcctStatusType_Set(status),
+		packet.AcctSessionID_Set(s.AcctSessionID),
+		packet.AcctAuthentic_Set(radius.AcctAuthentic_Value_RADIUS),
+		packet.EventTimestamp_Set(time.Now()),
+	}
+	for _, class := range s.class {
+		errs = append(errs, packet.Class_Add(class))
+	}
+	if status != radius.AcctStatusType_Value_Start {
+		c := s.Counters
+		errs = append(errs,
+			packet.AcctSessionTime_Set(uint32(time.Since(s.acctStart)/time.Second)),
+			packet.AcctInputOctets_Set(uint32(c.InputOctets)),
+			packet.AcctInputGigawords_Set(uint32(c.InputOctets>>32)),
+			packet.AcctOutputOctets_Set(uint32(c.OutputOctets)),
+			packet.AcctOutputGigawords_Set(uint32(c.OutputOctets>>32)),
+			packet.AcctInputPackets_Set(uint32(c.InputPackets)),
+			packet.AcctOutputPackets_Set(uint32(c.OutputPackets)),
+		)
+	}
+	for _, err := range errs {
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	return packet
+}
+
+// sendAccounting sends packet to the accounting server and waits for its
+// Accounting-Response.
+func (s *Session) sendAccounting(packet *radius.Packet) error {
+	defer s.flushCapture()
+	var client radius.Exchanger
+	switch {
+	case s.AcctClient != nil:
+		client = s.AcctClient
+	case s.AcctPool != nil:
+		client = s.AcctPool
+	default:
+		c, err := s.newClient(s.AcctServerIP.String())
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		client = c
+	}
+
+	status, _ := packet.AcctStatusType_Get()
+	resp, err := client.Exchange(s.exchangeContext(false), packet)
+	log.Printf("Identifier:%d %s %s", packet.Identifier, packet.Code, status)
+	if err != nil {
+		return err
+	}
+	s.dump("Received", resp)
+	s.count(func(st *Stats) { st.Replies++ })
+	if resp.Code != radius.CodeAccountingResponse {
+		s.count(func(st *Stats) { st.Dropped++ })
+		return errors.New("session: unexpected " + resp.Code.String())
+	}
+	log.Printf("Identifier:%d %s", resp.Identifier, resp.Code)
+	return nil
+}
+
+// runAccounting runs the accounting lifecycle of an authenticated session:
+// Accounting-On if requested, Start, periodic Interim-Update and Stop once
+// SessionTimeout expires, Stop is closed or a Disconnect-Request arrives.
+func (s *Session) runAccounting() {
+	if s.SendAccountingOn {
+		if err := s.AccountingOn(); err != nil {
+			log.Printf("Accounting-On: %s", err)
+		}
+	}
+	if err := s.AccountingStart(); err != nil {
+		log.Printf("Accounting Start: %s", err)
+		return
+	}
+
+	var interim, timeout <-chan time.Time
+	if s.InterimInterval > 0 {
+		ticker := time.NewTicker(s.InterimInterval)
+		defer ticker.Stop()
+		interim = ticker.C
+	}
+	if s.SessionTimeout > 0 {
+		timer := time.NewTimer(s.SessionTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	disconnect := make(chan struct{}, 1)
+	if s.DynAuthAddr != "" {
+		c, err := s.listenDynAuth(disconnect)
+		if err != nil {
+			log.Printf("Dynamic authorization: %s", err)
+		} else {
+			defer c.Close()
+		}
+	}
+
+	last := time.Now()
+	cause := radius.AcctTerminateCause_Value_UserRequest
+loop:
+	for {
+		select {
+		case now := <-interim:
+			s.addTraffic(now.Sub(last))
+			last = now
+			if err := s.AccountingInterim(); err != nil {
+				log.Printf("Accounting Interim-Update: %s", err)
+			}
+		case <-timeout:
+			cause = radius.AcctTerminateCause_Value_SessionTimeout
+			break loop
+		case <-s.Stop:
+			break loop
+		case <-disconnect:
+			cause = radius.AcctTerminateCause_Value_AdminReset
+			break loop
+		}
+	}
+
+	s.addTraffic(time.Since(last))
+	if err := s.AccountingStop(cause); err != nil {
+		log.Printf("Accounting Stop: %s", err)
+	}
+}
+
+// addTraffic adds d worth of synthetic traffic to the counters. The octet
+// counters are 64 bits wide, so they wrap into the Gigawords attributes
+// like on a real NAS.
+func (s *Session) addTraffic(d time.Duration) {
+	ms := uint64(d / time.Millisecond)
+	in := s.InputRate * ms / 1000
+	out := s.OutputRate * ms / 1000
+	s.Counters.InputOctets += in
+	s.Counters.OutputOctets += out
+	s.Counters.InputPackets += (in + syntheticPacketSize - 1) / syntheticPacketSize
+	s.Counters.OutputPackets += (out + syntheticPacketSize - 1) / syntheticPacketSize
+}
@@ -1,14 +1,23 @@
 package session
 
 type Context struct {
-	UserName   string
-	PassWord   string
+	UserName string
+	PassWord string
+	// NasAddr and ClientAddr are comma separated IPv4 and IPv6 addresses,
+	// sent as NAS-IP-Address or NAS-IPv6-Address and Framed-IP-Address or
+	// Framed-IPv6-Address.
 	NasAddr    string
 	NasPort    string
 	NasPasswd  string
 	VlanID     uint32
 	ClientAddr string
 	ClientMac  string
+	// ClientPrefix, ClientInterfaceID and DelegatedPrefix are sent, when
+	// set, as Framed-IPv6-Prefix, Framed-Interface-Id and
+	// Delegated-IPv6-Prefix.
+	ClientPrefix      string
+	ClientInterfaceID string
+	DelegatedPrefix   string
 }
 
 // func (c *Context) initTLS() {
@@ -0,0 +1,141 @@
+package session
+
+import (
+	"log"
+	"net"
+
+	"github.com/sdir/eapol_test/radius"
+)
+
+// listenDynAuth starts answering the RFC 5176 CoA-Requests and
+// Disconnect-Requests sent to s.DynAuthAddr. A Disconnect-Request for the
+// session is signalled on disconnect once it has been acknowledged. The
+// listener stops when the returned connection is closed.
+func (s *Session) listenDynAuth(disconnect chan<- struct{}) (*net.UDPConn, error) {
+	addr, err := net.ResolveUDPAddr("udp", s.DynAuthAddr)
+	if err != nil {
+		return nil, err
+	}
+	c, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Listening for CoA and Disconnect on %s", c.LocalAddr())
+	go s.serveDynAuth(c, disconnect)
+	return c, nil
+}
+
+func (s *Session) serveDynAuth(c *net.UDPConn, disconnect chan<- struct{}) {
+	for {
+		data := make([]byte, radius.MaxPacketLength)
+		n, addr, err := c.ReadFromUDP(data)
+		if err != nil {
+			return
+		}
+		req, err := radius.Parse(data[:n])
+		if err != nil {
+			log.Printf("Drop dynamic authorization request from %s: %s", addr, err)
+			continue
+		}
+		if req.Code != radius.CodeCoARequest && req.Code != radius.CodeDisconnectRequest {
+			log.Printf("Drop %s from %s", req.Code, addr)
+			continue
+		}
+		// RFC 5176 section 3.5: requests that fail authentication are
+		// silently discarded.
+		if err := req.VerifyRequest(s.context.NasPasswd); err != nil {
+			log.Printf("Drop %s from %s: %s", req.Code, addr, err)
+			continue
+		}
+
+		log.Printf("Identifier:%d %s from %s", req.Identifier, req.Code, addr)
+		s.logAttributes(req)
+		reply := s.dynAuthReply(req)
+		if _, ok := req.Lookup(radius.MessageAuthenticator_Type); ok || s.Strict {
+			// Signed again by EncodeReply.
+			reply.MessageAuthenticator_Prepend(s.context.NasPasswd)
+		}
+		b, err := reply.EncodeReply(req, s.context.NasPasswd)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if _, err := c.WriteToUDP(b, addr); err != nil {
+			log.Println(err)
+			continue
+		}
+		if cause, err := reply.ErrorCause_Get(); err == nil {
+			log.Printf("Identifier:%d %s %s", reply.Identifier, reply.Code, cause)
+		} else {
+			log.Printf("Identifier:%d %s", reply.Identifier, reply.Code)
+		}
+
+		if reply.Code == radius.CodeDisconnectACK {
+			select {
+			case disconnect <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// dynAuthReply returns the ACK or NAK answering req. The session attributes
+// of req must all match the session; NAK replies carry an Error-Cause.
+func (s *Session) dynAuthReply(req *radius.Packet) *radius.Packet {
+	ack, nak := radius.CodeCoAACK, radius.CodeCoANAK
+	if req.Code == radius.CodeDisconnectRequest {
+		ack, nak = radius.CodeDisconnectACK, radius.CodeDisconnectNAK
+	}
+	reply := &radius.Packet{Code: nak}
+
+	if !s.isNASAddress(req) {
+		reply.ErrorCause_Set(radius.ErrorCause_Value_NASIdentificationMismatch)
+		return reply
+	}
+
+	found := 0
+	for _, match := range []struct {
+		attr  radius.Type
+		value string
+	}{
+		{radius.AcctSessionID_Type, s.AcctSessionID},
+		{radius.UserName_Type, s.context.UserName},
+		{radius.CallingStationID_Type, s.context.ClientMac},
+	} {
+		a, ok := req.Lookup(match.attr)
+		if !ok {
+			continue
+		}
+		found++
+		if radius.String(a) != match.value {
+			reply.ErrorCause_Set(radius.ErrorCause_Value_SessionContextNotFound)
+			return reply
+		}
+	}
+	if found == 0 {
+		reply.ErrorCause_Set(radius.ErrorCause_Value_MissingAttribute)
+		return reply
+	}
+	reply.Code = ack
+	return reply
+}
+
+// isNASAddress reports whether the NAS-IP-Address and NAS-IPv6-Address of
+// req, if any, are addresses of the NAS.
+func (s *Session) isNASAddress(req *radius.Packet) bool {
+	nas, _ := parseAddresses(s.context.NasAddr)
+	for _, t := range []radius.Type{radius.NASIPAddress_Type, radius.NASIPv6Address_Type} {
+		a, ok := req.Lookup(t)
+		if !ok {
+			continue
+		}
+		found := false
+		for _, ip := range nas {
+			found = found || ip.Equal(net.IP(a))
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,30 @@
+package session
+
+// Method is the authentication method a Session runs.
+type Method int
+
+const (
+	MethodPEAP Method = iota
+	MethodPAP
+	MethodCHAP
+)
+
+var methodNames = map[Method]string{
+	MethodPEAP: "peap",
+	MethodPAP:  "pap",
+	MethodCHAP: "chap",
+}
+
+func (m Method) String() string {
+	return methodNames[m]
+}
+
+// ParseMethod returns the Method with the given name.
+func ParseMethod(name string) (Method, bool) {
+	for m, n := range methodNames {
+		if n == name {
+			return m, true
+		}
+	}
+	return 0, false
+}
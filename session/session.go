@@ -1,9 +1,17 @@
 package session
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"log"
 	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sdir/eapol_test/eap"
 	"github.com/sdir/eapol_test/radius"
@@ -12,8 +20,83 @@ import (
 
 type Session struct {
 	ServerIP net.UDPAddr
-	context  *Context
-	tlsCache *tlsCache.TLSCache
+	// Stats is updated by the read loops of the clients; read it with
+	// Statistics while the session runs.
+	Stats Stats
+	// Method selects the authentication method, PEAP-MSCHAPv2 by default.
+	Method Method
+	// Attributes are added to every Access-Request and Accounting-Request.
+	Attributes radius.Attributes
+	// Dictionary names the attributes of logged replies and encodes the
+	// Context attributes given as text. The default dictionary is used
+	// when nil.
+	Dictionary *radius.Dictionary
+	// Client sends the requests of the session. Sessions sharing a Client
+	// share its socket; Run creates its own for ServerIP when nil. Its
+	// Secret, "radsec" for a RadSec Client, replaces the NAS secret.
+	Client *radius.Client
+	// AcctClient likewise sends the accounting requests. A new Client for
+	// AcctServerIP is used for every request when nil.
+	AcctClient *radius.Client
+	// Pool, when set, replaces ServerIP and the NAS secret with servers
+	// tried in turn: an authentication is started again on the next server
+	// when one stops answering. AcctPool likewise replaces AcctServerIP.
+	// The pools are shared as they are: Run reports the retransmissions
+	// and drops of its own exchanges in Stats without changing them.
+	Pool     *radius.Pool
+	AcctPool *radius.Pool
+	// Retransmit overrides the RFC 5080 retransmission policy of the
+	// clients the session creates, radius.DefaultRetransmitPolicy.
+	Retransmit *radius.RetransmitPolicy
+	// Result is the final Access-Accept or Access-Reject code.
+	Result radius.Code
+	// Capture, if set, records the packets of the clients the session
+	// creates, with the key log of the PEAP tunnel so that Wireshark
+	// decrypts it. Client, AcctClient and the pools record to their own
+	// Capture. The records are flushed after the authentication and every
+	// accounting exchange.
+	Capture *radius.PcapWriter
+	// Verbose logs every request and reply in full, with their decoded
+	// attributes and EAP payload.
+	Verbose bool
+	// Strict enables the BlastRADIUS mitigations: Message-Authenticator is
+	// sent first in every request and required first in every reply.
+	Strict bool
+
+	// AcctServerIP is the accounting server, port 1813 on the
+	// authentication server by default.
+	AcctServerIP net.UDPAddr
+	// AcctSessionID identifies the session in accounting. It is generated
+	// by AccountingStart when empty.
+	AcctSessionID string
+	// Counters are reported in Interim-Update and Stop.
+	Counters Counters
+
+	// Accounting makes a successful Run continue into the accounting of
+	// the session: Start, Interim-Update every InterimInterval and Stop
+	// after SessionTimeout or when Stop is closed. The Acct-Interim-Interval
+	// and Session-Timeout of the Access-Accept are used when they are zero.
+	Accounting       bool
+	SendAccountingOn bool
+	InterimInterval  time.Duration
+	SessionTimeout   time.Duration
+	Stop             <-chan struct{}
+	// DynAuthAddr is the address, e.g. ":3799", on which CoA-Requests and
+	// Disconnect-Requests for the session are answered during accounting.
+	// A Disconnect ends the session with an Admin-Reset Stop.
+	DynAuthAddr string
+	// InputRate and OutputRate are the synthetic traffic, in octets per
+	// second, added to Counters during accounting.
+	InputRate  uint64
+	OutputRate uint64
+
+	context   *Context
+	tlsCache  *tlsCache.TLSCache
+	request   *radius.Packet
+	server    *radius.Server
+	class     [][]byte
+	acctStart time.Time
+	statsMu   sync.Mutex
 }
 
 func New(addr string, context *Context) *Session {
@@ -21,10 +104,27 @@ func New(addr string, context *Context) *Session {
 	if err != nil {
 		log.Panicln(err)
 	}
+	port := 1812
+	if host, p, err := net.SplitHostPort(addr); err == nil {
+		if n, err := strconv.Atoi(p); err == nil {
+			addr, port = host, n
+		}
+	}
+	// IPv6 link-local servers are given with their zone, e.g. fe80::1%eth0.
+	zone := ""
+	if i := strings.LastIndexByte(addr, '%'); i >= 0 {
+		addr, zone = addr[:i], addr[i+1:]
+	}
 	session := &Session{
 		ServerIP: net.UDPAddr{
 			IP:   net.ParseIP(addr),
-			Port: 1812,
+			Port: port,
+			Zone: zone,
+		},
+		AcctServerIP: net.UDPAddr{
+			IP:   net.ParseIP(addr),
+			Port: 1813,
+			Zone: zone,
 		},
 		context:  context,
 		tlsCache: tlsCache,
@@ -35,14 +135,7 @@ func New(addr string, context *Context) *Session {
 func (s *Session) InitRadius() *radius.Packet {
 	packet := radius.New()
 
-	packet.SetUserName(s.context.UserName)
-	packet.NASIPAddress_Add(s.context.NasAddr)
-	packet.NASPortID_Add(s.context.NasPort, s.context.VlanID)
-	packet.CallingStationID_Add(s.context.ClientMac)
-	packet.ServiceType_Add(radius.ServiceType_Value_FramedUser)
-	packet.NASPortType_Add(radius.NASPortType_Value_Ethernet)
-	packet.FramedIPAddress_Add(s.context.ClientAddr)
-	packet.FramedMTU_Add(1400)
+	s.setNasAttributes(packet)
 
 	eapPacket := eap.NewEapIdentity()
 	eapPacket.SetIdentity(s.context.UserName)
@@ -52,21 +145,208 @@ func (s *Session) InitRadius() *radius.Packet {
 		packet.EAPMessage_Set(eapMsg)
 	}
 
-	packet.MessageAuthenticator_Set(s.context.NasPasswd)
+	s.sign(packet)
+
+	return packet
+}
+
+// InitPAP builds a PAP Access-Request carrying the hidden User-Password.
+func (s *Session) InitPAP() *radius.Packet {
+	packet := radius.New()
+
+	s.setNasAttributes(packet)
+	if err := packet.UserPassword_Set(s.context.PassWord, s.secret()); err != nil {
+		log.Println(err)
+	}
+
+	s.sign(packet)
 
 	return packet
 }
 
-func (s *Session) reply(data []byte) []byte {
-	req, err := radius.Parse(data)
+// InitCHAP builds a CHAP Access-Request with a random CHAP-Challenge.
+func (s *Session) InitCHAP() *radius.Packet {
+	packet := radius.New()
+
+	s.setNasAttributes(packet)
+	challenge, err := radius.NewCHAPChallenge()
 	if err != nil {
 		log.Println(err)
-		return []byte{}
+		return nil
+	}
+	if err := packet.CHAPPassword_Set(challenge[0], s.context.PassWord, challenge); err != nil {
+		log.Println(err)
+	}
+
+	s.sign(packet)
+
+	return packet
+}
+
+func (s *Session) setNasAttributes(packet *radius.Packet) {
+	nasPortID := fmt.Sprintf("slot=%d;subslot=%d;port=%d;vlanid=%d;interfaceName=%s",
+		0, 0, 0, s.context.VlanID, s.context.NasPort)
+	for _, err := range []error{
+		packet.UserName_Set(s.context.UserName),
+		addAddresses(s.context.NasAddr, packet.NASIPAddress_Add, packet.NASIPv6Address_Add),
+		packet.NASPortID_Set(nasPortID),
+		packet.CallingStationID_Set(s.context.ClientMac),
+		packet.ServiceType_Add(radius.ServiceType_Value_FramedUser),
+		packet.NASPortType_Add(radius.NASPortType_Value_Ethernet),
+		addAddresses(s.context.ClientAddr, packet.FramedIPAddress_Add, packet.FramedIPv6Address_Add),
+		s.addAttribute(packet, "Framed-IPv6-Prefix", s.context.ClientPrefix),
+		s.addAttribute(packet, "Framed-Interface-Id", s.context.ClientInterfaceID),
+		s.addAttribute(packet, "Delegated-IPv6-Prefix", s.context.DelegatedPrefix),
+		packet.FramedMTU_Add(1400),
+	} {
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	for _, avp := range s.Attributes {
+		packet.Add(avp.Type, avp.Attribute)
+	}
+}
+
+// parseAddresses parses a comma separated list of IP addresses.
+func parseAddresses(list string) ([]net.IP, error) {
+	var ips []net.IP
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		ip := net.ParseIP(field)
+		if ip == nil {
+			return nil, fmt.Errorf("session: invalid address %q", field)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// addAddresses adds the comma separated addresses of list to a packet with
+// add4 for IPv4 addresses and add6 for IPv6 ones.
+func addAddresses(list string, add4, add6 func(net.IP) error) error {
+	ips, err := parseAddresses(list)
+	if err != nil {
+		return err
 	}
+	for _, ip := range ips {
+		add := add6
+		if ip.To4() != nil {
+			add = add4
+		}
+		if err := add(ip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAttribute adds the attribute called name in the dictionary of s with
+// the text value to packet, unless value is empty.
+func (s *Session) addAttribute(packet *radius.Packet, name, value string) error {
+	if value == "" {
+		return nil
+	}
+	attrs, err := s.dictionary().NewAttributes(name, value)
+	if err != nil {
+		return err
+	}
+	packet.Attributes = append(packet.Attributes, attrs...)
+	return nil
+}
+
+// sign adds the Message-Authenticator to packet, first in strict mode.
+func (s *Session) sign(packet *radius.Packet) {
+	if s.Strict {
+		packet.MessageAuthenticator_Prepend(s.secret())
+	} else {
+		packet.MessageAuthenticator_Set(s.secret())
+	}
+}
+
+// Errors of the replies that verify drops.
+var (
+	errStrictMissing    = errors.New("session: strict mode requires Message-Authenticator")
+	errStrictNotFirst   = errors.New("session: strict mode requires Message-Authenticator first")
+	errEapNoMessageAuth = errors.New("session: EAP reply without Message-Authenticator")
+)
+
+// verify checks that reply, an authentic reply to req, follows the
+// Message-Authenticator rules. It is the Verify hook of the authentication
+// exchanges, so a reply it rejects is dropped and the next one awaited.
+func (s *Session) verify(reply, req *radius.Packet) error {
+	_, hasEap := reply.Lookup(radius.EAPMessage_Type)
+	_, hasMsgAuth := reply.Lookup(radius.MessageAuthenticator_Type)
+	isFirst := reply.MessageAuthenticator_IsFirst()
+	if s.Strict {
+		log.Printf("BlastRADIUS: reply %d Message-Authenticator present=%v first=%v",
+			reply.Identifier, hasMsgAuth, isFirst)
+		if !hasMsgAuth {
+			return errStrictMissing
+		}
+		if !isFirst {
+			return errStrictNotFirst
+		}
+	}
+	// RFC 3579 section 3.2: a reply carrying EAP-Message without a valid
+	// Message-Authenticator must be silently discarded. The Client checked
+	// its value.
+	if hasEap && !hasMsgAuth {
+		return errEapNoMessageAuth
+	}
+	return nil
+}
+
+// accept counts req, the reply the Client matched and verified.
+func (s *Session) accept(req *radius.Packet) {
+	_, hasMsgAuth := req.Lookup(radius.MessageAuthenticator_Type)
+	isFirst := req.MessageAuthenticator_IsFirst()
+	s.count(func(st *Stats) {
+		st.Replies++
+		if hasMsgAuth {
+			st.MessageAuthenticatorPresent++
+		}
+		if isFirst {
+			st.MessageAuthenticatorFirst++
+		}
+	})
+}
+
+// reply handles req and returns the next request to send, or nil when the
+// authentication is over.
+func (s *Session) reply(req *radius.Packet) *radius.Packet {
+	switch req.Code {
+	case radius.CodeAccessAccept:
+		s.Result = req.Code
+		log.Printf("Identifier:%d %s", req.Identifier, req.Code)
+		s.logAttributes(req)
+		s.showKeys(req)
+		s.class, _ = req.Class_Gets()
+		if v, err := req.AcctInterimInterval_Get(); err == nil && s.InterimInterval == 0 {
+			s.InterimInterval = time.Duration(v) * time.Second
+		}
+		if v, err := req.SessionTimeout_Get(); err == nil && s.SessionTimeout == 0 {
+			s.SessionTimeout = time.Duration(v) * time.Second
+		}
+		return nil
+	case radius.CodeAccessReject:
+		s.Result = req.Code
+		log.Printf("Identifier:%d %s", req.Identifier, req.Code)
+		s.logAttributes(req)
+		return nil
+	}
+	if s.Method != MethodPEAP {
+		log.Printf("Unexpected %s for method %s", req.Code, s.Method)
+		return nil
+	}
+
 	reqEapData, err := req.EAPMessage_Get()
 	if err != nil {
 		log.Println(err)
-		return []byte{}
+		return nil
 	}
 	reqEapPacket := eap.Decode(reqEapData, nil)
 
@@ -78,14 +358,7 @@ func (s *Session) reply(data []byte) []byte {
 		case eap.Peap:
 			packet := radius.NewReply(req)
 
-			packet.SetUserName(s.context.UserName)
-			packet.NASIPAddress_Add(s.context.NasAddr)
-			packet.NASPortID_Add(s.context.NasPort, s.context.VlanID)
-			packet.CallingStationID_Add(s.context.ClientMac)
-			packet.ServiceType_Add(radius.ServiceType_Value_FramedUser)
-			packet.NASPortType_Add(radius.NASPortType_Value_Ethernet)
-			packet.FramedIPAddress_Add(s.context.ClientAddr)
-			packet.FramedMTU_Add(1400)
+			s.setNasAttributes(packet)
 
 			reqPeapPacket := reqEapPacket.(*eap.EapPeap)
 
@@ -122,16 +395,19 @@ func (s *Session) reply(data []byte) []byte {
 						peerChallenge := eap.RandPeerChallenge()
 						authenticatorChallenge := msPacket.GetAuthChallenge()
 
-						log.Println("\npeer: \n" + hex.Dump(peerChallenge))
-						log.Println("\nauth: \n" + hex.Dump(authenticatorChallenge))
+						if s.Verbose {
+							log.Printf("MsChapv2 peer challenge 0x%x", peerChallenge)
+							log.Printf("MsChapv2 authenticator challenge 0x%x", authenticatorChallenge)
+						}
 
 						ntResponse := eap.GenerateNTResponse(s.context.UserName, s.context.PassWord,
 							authenticatorChallenge, peerChallenge)
 						// authResponse := eap.GenerateAuthenticatorResponse(s.context.UserName, s.context.PassWord,
 						// 	ntResponse, authenticatorChallenge, peerChallenge)
 
-						log.Println("\nnt: \n" + hex.Dump(ntResponse))
-						// log.Println("\nresponse: \n" + hex.Dump(authResponse))
+						if s.Verbose {
+							log.Printf("MsChapv2 NT-Response 0x%x", ntResponse)
+						}
 
 						var response []byte
 						response = append(response, peerChallenge...)
@@ -178,14 +454,8 @@ func (s *Session) reply(data []byte) []byte {
 			if ok, eapMsg := peapPacket.Encode(); ok {
 				packet.EAPMessage_Set(eapMsg)
 			}
-			packet.MessageAuthenticator_Set(s.context.NasPasswd)
-
-			data, err := packet.MarshalBinary()
-			if err == nil {
-				return data
-			} else {
-				log.Println(err)
-			}
+			s.sign(packet)
+			return packet
 
 		default:
 			log.Printf("Not find eap type %d", reqEapPacket.GetType())
@@ -193,41 +463,251 @@ func (s *Session) reply(data []byte) []byte {
 	case eap.EAPSuccess:
 	case eap.EAPFailure:
 	}
-	return []byte{}
+	return nil
 }
 
-func (s *Session) Run() {
-	c, err := net.DialUDP("udp", nil, &s.ServerIP)
-	if err != nil {
-		log.Printf("Run error: %s", err)
+// dropped counts a reply the Client dropped. It is called from the read
+// loop of the Client.
+func (s *Session) dropped(data []byte, err error) {
+	s.count(func(st *Stats) {
+		st.Replies++
+		st.Dropped++
+		switch err {
+		case radius.ErrInvalidAuthenticator:
+			st.BadAuthenticator++
+		case radius.ErrInvalidMessageAuthenticator, errStrictMissing, errStrictNotFirst, errEapNoMessageAuth:
+			st.BadMessageAuthenticator++
+		}
+	})
+	log.Printf("Drop reply: %s", err)
+}
+
+// count applies update to s.Stats under its lock.
+func (s *Session) count(update func(st *Stats)) {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	update(&s.Stats)
+}
+
+// Statistics returns a copy of s.Stats. Unlike reading Stats, it is safe
+// while replies are still being received.
+func (s *Session) Statistics() Stats {
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	return s.Stats
+}
+
+// attempt dumps a request once encoded, and logs and counts its
+// retransmissions. It is the Attempt hook of every exchange of s.
+func (s *Session) attempt(p *radius.Packet, attempt int, timeout time.Duration) {
+	if attempt == 1 {
+		s.dump("Sent", p)
 		return
 	}
-	defer c.Close()
+	s.count(func(st *Stats) { st.Retransmits++ })
+	log.Printf("Identifier:%d %s retransmission %d, timeout %s", p.Identifier, p.Code, attempt-1, timeout)
+}
 
-	p := s.InitRadius()
-	data, err := p.MarshalBinary()
+// newClient returns a Client for the server at addr reporting to s.
+func (s *Session) newClient(addr string) (*radius.Client, error) {
+	c, err := radius.NewClient(addr, s.secret())
 	if err != nil {
+		return nil, err
+	}
+	if s.Retransmit != nil {
+		c.Retransmit = *s.Retransmit
+	}
+	// The replies that answer no exchange of s, which reports the others
+	// through the hooks of its exchanges.
+	c.Dropped = s.dropped
+	c.Capture = s.Capture
+	return c, nil
+}
+
+// dump logs p in full when s.Verbose is set.
+func (s *Session) dump(prefix string, p *radius.Packet) {
+	if !s.Verbose {
+		return
+	}
+	var b strings.Builder
+	if err := s.dictionary().Dump(&b, p); err != nil {
 		log.Println(err)
 		return
 	}
+	log.Printf("%s %s", prefix, b.String())
+}
+
+// dictionary returns s.Dictionary or the default dictionary.
+func (s *Session) dictionary() *radius.Dictionary {
+	if s.Dictionary != nil {
+		return s.Dictionary
+	}
+	return radius.DefaultDictionary()
+}
+
+// logAttributes logs the attributes of a reply by their dictionary names.
+func (s *Session) logAttributes(req *radius.Packet) {
+	dict := s.dictionary()
+	var attrs radius.Attributes
+	for _, avp := range req.Attributes {
+		switch avp.Type {
+		case radius.EAPMessage_Type, radius.MessageAuthenticator_Type:
+			continue
+		}
+		attrs = append(attrs, avp)
+	}
+	for _, line := range dict.FormatAttributes(attrs) {
+		log.Printf("  %s", line)
+	}
+}
+
+// showKeys logs the salt encrypted key material of an Access-Accept and, for
+// PEAP, compares the MS-MPPE keys with the ones derived from the TLS tunnel.
+func (s *Session) showKeys(accept *radius.Packet) {
+	if tag, password, err := accept.TunnelPassword_Get(s.request, s.secret()); err == nil {
+		log.Printf("Tunnel-Password:%d %q", tag, password)
+	} else if err != radius.ErrNoAttribute {
+		log.Printf("Tunnel-Password: %s", err)
+	}
 
-	c.Write(data)
+	sendKey, err := accept.MSMPPESendKey_Get(s.request, s.secret())
+	if err != nil {
+		if err != radius.ErrNoAttribute {
+			log.Printf("MS-MPPE-Send-Key: %s", err)
+		}
+		return
+	}
+	recvKey, err := accept.MSMPPERecvKey_Get(s.request, s.secret())
+	if err != nil {
+		log.Printf("MS-MPPE-Recv-Key: %s", err)
+		return
+	}
+	log.Println("\nMS-MPPE-Send-Key: \n" + hex.Dump(sendKey))
+	log.Println("\nMS-MPPE-Recv-Key: \n" + hex.Dump(recvKey))
 
-	for {
-		data := make([]byte, 2048)
-		n, _, err := c.ReadFromUDP(data)
+	if s.Method != MethodPEAP || s.tlsCache == nil || s.tlsCache.HandStaus != tlsCache.HandOK {
+		return
+	}
+	// The MSK is the first 64 bytes of the TLS PRF output; the server sends
+	// its first half as MS-MPPE-Recv-Key and the second as MS-MPPE-Send-Key.
+	msk, err := s.tlsCache.ExportKeyingMaterial("client EAP encryption", 64)
+	if err != nil {
+		log.Printf("MSK: %s", err)
+		return
+	}
+	log.Printf("MS-MPPE-Recv-Key match=%v MS-MPPE-Send-Key match=%v",
+		bytes.Equal(recvKey, msk[:32]), bytes.Equal(sendKey, msk[32:]))
+}
+
+// secret returns the secret shared with the current authentication server.
+func (s *Session) secret() string {
+	if s.server != nil {
+		return s.server.Secret
+	}
+	if s.Client != nil {
+		return s.Client.Secret
+	}
+	return s.context.NasPasswd
+}
+
+// exchangeContext returns the context of the exchanges of s, whose hooks
+// report to its Stats whatever Client or Pool sends them. Authentication
+// replies are also checked by verify.
+func (s *Session) exchangeContext(verify bool) context.Context {
+	h := &radius.Hooks{Attempt: s.attempt, Dropped: s.dropped}
+	if verify {
+		h.Verify = s.verify
+	}
+	return radius.WithHooks(context.Background(), h)
+}
+
+// flushCapture writes the records of s.Capture.
+func (s *Session) flushCapture() {
+	if s.Capture == nil {
+		return
+	}
+	if err := s.Capture.Flush(); err != nil {
+		log.Printf("Capture: %s", err)
+	}
+}
+
+// authenticate runs the authentication exchanges with the server of
+// client until no request is left, and returns the error that ended them
+// early.
+func (s *Session) authenticate(client radius.Exchanger) error {
+	defer s.flushCapture()
+	if s.Capture != nil {
+		s.tlsCache.SetKeyLog(s.Capture.KeyLog())
+	}
+	var p *radius.Packet
+	switch s.Method {
+	case MethodPAP:
+		p = s.InitPAP()
+	case MethodCHAP:
+		p = s.InitCHAP()
+	default:
+		p = s.InitRadius()
+	}
+
+	ctx := s.exchangeContext(true)
+	for p != nil {
+		s.request = p
+		req, err := client.Exchange(ctx, p)
 		if err != nil {
-			log.Printf("Read server error: %s", err)
-			return
+			return err
 		}
+		s.dump("Received", req)
+		s.accept(req)
+		p = s.reply(req)
+	}
+	return nil
+}
 
-		if n > 0 {
-			rdata := s.reply(data[0:n])
-			if len(rdata) == 0 {
-				break
+// authenticatePool runs the authentication with the servers of s.Pool,
+// starting again on the next server when one stops answering.
+func (s *Session) authenticatePool() error {
+	err := radius.ErrNoServer
+	for _, server := range s.Pool.Select() {
+		if s.server != nil {
+			log.Printf("Failing over to %s", server.Addr)
+			cache, cacheErr := tlsCache.New()
+			if cacheErr != nil {
+				return cacheErr
 			}
-			c.Write(rdata)
+			s.tlsCache, s.class, s.Result = cache, nil, 0
 		}
+		s.server = server
+		if err = s.authenticate(server); err == nil || !server.Dead() {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *Session) Run() {
+	if s.Pool != nil {
+		if err := s.authenticatePool(); err != nil {
+			log.Printf("Run error: %s", err)
+			return
+		}
+	} else {
+		client := s.Client
+		if client == nil {
+			c, err := s.newClient(s.ServerIP.String())
+			if err != nil {
+				log.Printf("Run error: %s", err)
+				return
+			}
+			defer c.Close()
+			client = c
+		}
+		if err := s.authenticate(client); err != nil {
+			log.Printf("Run error: %s", err)
+			return
+		}
+	}
 
+	if s.Accounting && s.Result == radius.CodeAccessAccept {
+		s.runAccounting()
 	}
 }
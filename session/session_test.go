@@ -1,8 +1,18 @@
 package session
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/sdir/eapol_test/radius"
 )
 
 func TestSession_Send(t *testing.T) {
@@ -44,3 +54,479 @@ func TestSession_Send(t *testing.T) {
 		})
 	}
 }
+
+// accountingServer starts an accounting server answering every request
+// signed with "secret" and returns its address and the requests received.
+func accountingServer(t *testing.T) (*net.UDPAddr, <-chan *radius.Packet) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	requests := make(chan *radius.Packet, 16)
+	go func() {
+		for {
+			b := make([]byte, radius.MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := radius.Parse(b[:n])
+			if err != nil || req.VerifyRequest("secret") != nil {
+				continue
+			}
+			requests <- req
+			reply := &radius.Packet{Code: radius.CodeAccountingResponse}
+			if b, err := reply.EncodeReply(req, "secret"); err == nil {
+				c.WriteToUDP(b, addr)
+			}
+		}
+	}()
+	return c.LocalAddr().(*net.UDPAddr), requests
+}
+
+func TestSession_Accounting(t *testing.T) {
+	addr, requests := accountingServer(t)
+	s := &Session{
+		AcctServerIP: *addr,
+		context:      &Context{UserName: "user", NasPasswd: "secret"},
+		class:        [][]byte{[]byte("class-1")},
+	}
+	if err := s.AccountingStart(); err != nil {
+		t.Fatal(err)
+	}
+	s.Counters.InputOctets = 5<<32 + 7
+	if err := s.AccountingStop(radius.AcctTerminateCause_Value_UserRequest); err != nil {
+		t.Fatal(err)
+	}
+
+	start, stop := <-requests, <-requests
+	if status, _ := start.AcctStatusType_Get(); status != radius.AcctStatusType_Value_Start {
+		t.Errorf("first Acct-Status-Type = %s", status)
+	}
+	if id, _ := stop.AcctSessionID_Get(); id == "" || id != s.AcctSessionID {
+		t.Errorf("Acct-Session-Id = %q, want %q", id, s.AcctSessionID)
+	}
+	if class, _ := stop.Class_Get(); string(class) != "class-1" {
+		t.Errorf("Class = %q", class)
+	}
+	octets, _ := stop.AcctInputOctets_Get()
+	gigawords, _ := stop.AcctInputGigawords_Get()
+	if octets != 7 || gigawords != 5 {
+		t.Errorf("Acct-Input-Octets = %d, Acct-Input-Gigawords = %d", octets, gigawords)
+	}
+	if cause, _ := stop.AcctTerminateCause_Get(); cause != radius.AcctTerminateCause_Value_UserRequest {
+		t.Errorf("Acct-Terminate-Cause = %s", cause)
+	}
+}
+
+func TestSession_Capture(t *testing.T) {
+	addr, _ := accountingServer(t)
+	s := &Session{
+		AcctServerIP: *addr,
+		context:      &Context{UserName: "user", NasPasswd: "secret"},
+	}
+	var capture bytes.Buffer
+	s.Capture, _ = radius.NewPcapWriter(&capture, "")
+	if err := s.AccountingStart(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AccountingStop(radius.AcctTerminateCause_Value_UserRequest); err != nil {
+		t.Fatal(err)
+	}
+
+	// Start and Stop with their responses, after the section header and
+	// interface blocks.
+	blocks := 0
+	for b := capture.Bytes(); len(b) >= 8; b = b[binary.LittleEndian.Uint32(b[4:]):] {
+		blocks++
+	}
+	if blocks != 2+4 {
+		t.Errorf("%d pcapng blocks captured, want 6", blocks)
+	}
+}
+
+func TestSession_Verbose(t *testing.T) {
+	addr, requests := accountingServer(t)
+	c, err := radius.NewClient(addr.String(), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	// The requests sent with a Client of the caller are logged too, as
+	// they went on the wire: the second one gets the next Identifier.
+	s := &Session{
+		AcctClient: c,
+		Verbose:    true,
+		context:    &Context{UserName: "user", NasPasswd: "secret"},
+	}
+	for i := 0; i < 2; i++ {
+		if err := s.AccountingOn(); err != nil {
+			t.Fatal(err)
+		}
+		req := <-requests
+		b, _ := req.MarshalBinary()
+		for _, want := range []string{
+			fmt.Sprintf("Sent Accounting-Request Id %d length %d\n", req.Identifier, len(b)),
+			fmt.Sprintf("\tAuthenticator = 0x%x\n", req.Authenticator),
+			"Received Accounting-Response",
+		} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("log missing %q in:\n%s", want, out.String())
+			}
+		}
+		out.Reset()
+	}
+}
+
+func TestSession_runAccounting(t *testing.T) {
+	addr, requests := accountingServer(t)
+	s := &Session{
+		AcctServerIP:     *addr,
+		context:          &Context{UserName: "user", NasPasswd: "secret"},
+		SendAccountingOn: true,
+		InterimInterval:  20 * time.Millisecond,
+		SessionTimeout:   70 * time.Millisecond,
+		Counters:         Counters{InputOctets: 1<<32 - 1000},
+		InputRate:        1 << 20,
+	}
+	s.runAccounting()
+
+	var statuses []radius.AcctStatusType
+	var stop *radius.Packet
+	for len(requests) > 0 {
+		req := <-requests
+		status, _ := req.AcctStatusType_Get()
+		statuses = append(statuses, status)
+		stop = req
+	}
+	if len(statuses) < 4 || statuses[0] != radius.AcctStatusType_Value_AccountingOn ||
+		statuses[1] != radius.AcctStatusType_Value_Start ||
+		statuses[2] != radius.AcctStatusType_Value_InterimUpdate ||
+		statuses[len(statuses)-1] != radius.AcctStatusType_Value_Stop {
+		t.Fatalf("Acct-Status-Types = %v", statuses)
+	}
+	if cause, _ := stop.AcctTerminateCause_Get(); cause != radius.AcctTerminateCause_Value_SessionTimeout {
+		t.Errorf("Acct-Terminate-Cause = %s", cause)
+	}
+	if gigawords, _ := stop.AcctInputGigawords_Get(); gigawords != 1 {
+		t.Errorf("Acct-Input-Gigawords = %d, want 1", gigawords)
+	}
+	if packets, _ := stop.AcctInputPackets_Get(); packets == 0 {
+		t.Error("Acct-Input-Packets = 0")
+	}
+}
+
+func TestSession_DynAuth(t *testing.T) {
+	s := &Session{
+		DynAuthAddr:   "127.0.0.1:0",
+		AcctSessionID: "0001",
+		context:       &Context{UserName: "user", NasPasswd: "secret", NasAddr: "192.0.2.1"},
+	}
+	disconnect := make(chan struct{}, 1)
+	l, err := s.listenDynAuth(disconnect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	tests := []struct {
+		name      string
+		code      radius.Code
+		secret    string
+		sessionID string
+		nasAddr   string
+		want      radius.Code
+		cause     radius.ErrorCause
+	}{
+		{name: "coa", code: radius.CodeCoARequest, secret: "secret", sessionID: "0001", want: radius.CodeCoAACK},
+		{name: "wrong secret", code: radius.CodeDisconnectRequest, secret: "wrong", sessionID: "0001"},
+		{name: "missing attribute", code: radius.CodeDisconnectRequest, secret: "secret",
+			want: radius.CodeDisconnectNAK, cause: radius.ErrorCause_Value_MissingAttribute},
+		{name: "other session", code: radius.CodeDisconnectRequest, secret: "secret", sessionID: "0002",
+			want: radius.CodeDisconnectNAK, cause: radius.ErrorCause_Value_SessionContextNotFound},
+		{name: "other nas", code: radius.CodeDisconnectRequest, secret: "secret", sessionID: "0001", nasAddr: "192.0.2.2",
+			want: radius.CodeDisconnectNAK, cause: radius.ErrorCause_Value_NASIdentificationMismatch},
+		{name: "disconnect", code: radius.CodeDisconnectRequest, secret: "secret", sessionID: "0001", want: radius.CodeDisconnectACK},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := net.DialUDP("udp", nil, l.LocalAddr().(*net.UDPAddr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer c.Close()
+
+			req := &radius.Packet{Code: tt.code, Identifier: byte(i)}
+			if tt.sessionID != "" {
+				req.AcctSessionID_Set(tt.sessionID)
+			}
+			if tt.nasAddr != "" {
+				req.NASIPAddress_Set(net.ParseIP(tt.nasAddr))
+			}
+			b, err := req.Encode(tt.secret)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Write(b)
+
+			c.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+			b = make([]byte, radius.MaxPacketLength)
+			n, err := c.Read(b)
+			if tt.want == 0 {
+				if err == nil {
+					t.Error("reply to a request with the wrong secret")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			reply, err := radius.Parse(b[:n])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := reply.VerifyResponse(req, "secret"); err != nil {
+				t.Error(err)
+			}
+			if reply.Code != tt.want {
+				t.Errorf("reply %s, want %s", reply.Code, tt.want)
+			}
+			if cause, _ := reply.ErrorCause_Get(); cause != tt.cause {
+				t.Errorf("Error-Cause = %s, want %s", cause, tt.cause)
+			}
+		})
+	}
+
+	select {
+	case <-disconnect:
+	case <-time.After(time.Second):
+		t.Error("Disconnect-ACK did not end the session")
+	}
+}
+
+func TestSession_CHAP(t *testing.T) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	go func() {
+		for {
+			b := make([]byte, radius.MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := radius.Parse(b[:n])
+			if err != nil {
+				continue
+			}
+			reply := &radius.Packet{Code: radius.CodeAccessReject}
+			if req.CHAPPassword_Verify("password") == nil {
+				reply.Code = radius.CodeAccessAccept
+			}
+			if b, err := reply.EncodeReply(req, "secret"); err == nil {
+				c.WriteToUDP(b, addr)
+			}
+		}
+	}()
+
+	for _, tt := range []struct {
+		password string
+		want     radius.Code
+	}{
+		{"password", radius.CodeAccessAccept},
+		{"wrong", radius.CodeAccessReject},
+	} {
+		s := New(c.LocalAddr().String(), &Context{UserName: "user", PassWord: tt.password, NasPasswd: "secret"})
+		s.Method = MethodCHAP
+		s.Run()
+		if s.Result != tt.want {
+			t.Errorf("password %q: Result = %s, want %s", tt.password, s.Result, tt.want)
+		}
+	}
+}
+
+func TestSession_DroppedReplies(t *testing.T) {
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	go func() {
+		for {
+			b := make([]byte, radius.MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := radius.Parse(b[:n])
+			if err != nil {
+				continue
+			}
+			// A forged reply and one without the Message-Authenticator
+			// that strict mode requires arrive before the real one.
+			forged := &radius.Packet{Code: radius.CodeAccessReject}
+			unsigned := &radius.Packet{Code: radius.CodeAccessReject}
+			reply := &radius.Packet{Code: radius.CodeAccessAccept}
+			reply.MessageAuthenticator_Prepend("secret")
+			for _, p := range []struct {
+				packet *radius.Packet
+				secret string
+			}{{forged, "forged"}, {unsigned, "secret"}, {reply, "secret"}} {
+				if b, err := p.packet.EncodeReply(req, p.secret); err == nil {
+					c.WriteToUDP(b, addr)
+				}
+			}
+		}
+	}()
+
+	s := New(c.LocalAddr().String(), &Context{UserName: "user", PassWord: "password", NasPasswd: "secret"})
+	s.Method = MethodPAP
+	s.Strict = true
+	s.Run()
+	if s.Result != radius.CodeAccessAccept {
+		t.Errorf("Result = %s, want Access-Accept", s.Result)
+	}
+	want := Stats{
+		Replies:                     3,
+		Dropped:                     2,
+		BadAuthenticator:            1,
+		BadMessageAuthenticator:     1,
+		MessageAuthenticatorPresent: 1,
+		MessageAuthenticatorFirst:   1,
+	}
+	if got := s.Statistics(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+}
+
+func TestSession_Pool(t *testing.T) {
+	silent, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer silent.Close()
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	go func() {
+		for {
+			b := make([]byte, radius.MaxPacketLength)
+			n, addr, err := c.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+			req, err := radius.Parse(b[:n])
+			if err != nil {
+				continue
+			}
+			reply := &radius.Packet{Code: radius.CodeAccessReject}
+			if password, err := req.UserPassword_Get("secondary"); err == nil && password == "password" {
+				reply.Code = radius.CodeAccessAccept
+			}
+			if b, err := reply.EncodeReply(req, "secondary"); err == nil {
+				c.WriteToUDP(b, addr)
+			}
+		}
+	}()
+
+	pool := radius.NewPool(
+		&radius.Server{Addr: silent.LocalAddr().String(), Secret: "primary"},
+		&radius.Server{Addr: c.LocalAddr().String(), Secret: "secondary"},
+	)
+	defer pool.Close()
+	pool.Retransmit = &radius.RetransmitPolicy{IRT: 10 * time.Millisecond, MRC: 1}
+	s := &Session{
+		Method:  MethodPAP,
+		Pool:    pool,
+		context: &Context{UserName: "user", PassWord: "password", NasPasswd: "primary"},
+	}
+	s.Run()
+	if s.Result != radius.CodeAccessAccept {
+		t.Errorf("Result = %s, want Access-Accept from the secondary", s.Result)
+	}
+	if n := s.Statistics().Retransmits; n != 1 {
+		t.Errorf("%d retransmissions, want 1", n)
+	}
+	if pool.Attempt != nil || pool.Dropped != nil || pool.Capture != nil {
+		t.Error("Run changed the callbacks of the pool")
+	}
+}
+
+func TestSession_IPv6(t *testing.T) {
+	s := New("[fe80::1%lo]:1645", &Context{
+		UserName:          "user",
+		NasAddr:           "2001:db8::1",
+		ClientAddr:        "192.0.2.10, 2001:db8::10",
+		ClientPrefix:      "2001:db8:1::/64",
+		ClientInterfaceID: "0000:0000:0000:0001",
+		DelegatedPrefix:   "2001:db8:2::/56",
+	})
+	if s.ServerIP.String() != "[fe80::1%lo]:1645" || s.AcctServerIP.Zone != "lo" {
+		t.Errorf("server %s, accounting server %s", &s.ServerIP, &s.AcctServerIP)
+	}
+
+	p := s.InitPAP()
+	if _, ok := p.Lookup(radius.NASIPAddress_Type); ok {
+		t.Error("NAS-IP-Address sent for an IPv6 NAS")
+	}
+	if ip, _ := p.NASIPv6Address_Get(); !ip.Equal(net.ParseIP("2001:db8::1")) {
+		t.Errorf("NAS-IPv6-Address = %s", ip)
+	}
+	if ip, _ := p.FramedIPAddress_Get(); !ip.Equal(net.ParseIP("192.0.2.10")) {
+		t.Errorf("Framed-IP-Address = %s", ip)
+	}
+	if ip, _ := p.FramedIPv6Address_Get(); !ip.Equal(net.ParseIP("2001:db8::10")) {
+		t.Errorf("Framed-IPv6-Address = %s", ip)
+	}
+	if prefix, _ := p.FramedIPv6Prefix_Get(); prefix.String() != "2001:db8:1::/64" {
+		t.Errorf("Framed-IPv6-Prefix = %s", prefix)
+	}
+	if prefix, _ := p.DelegatedIPv6Prefix_Get(); prefix.String() != "2001:db8:2::/56" {
+		t.Errorf("Delegated-IPv6-Prefix = %s", prefix)
+	}
+	if id, _ := p.FramedInterfaceID_Get(); len(id) != 8 || id[7] != 1 {
+		t.Errorf("Framed-Interface-Id = %x", id)
+	}
+
+	req := &radius.Packet{Code: radius.CodeDisconnectRequest}
+	req.UserName_Set("user")
+	req.NASIPv6Address_Set(net.ParseIP("2001:db8::2"))
+	if cause, _ := s.dynAuthReply(req).ErrorCause_Get(); cause != radius.ErrorCause_Value_NASIdentificationMismatch {
+		t.Errorf("Error-Cause = %s for another NAS-IPv6-Address", cause)
+	}
+	req.NASIPv6Address_Set(net.ParseIP("2001:db8::1"))
+	if reply := s.dynAuthReply(req); reply.Code != radius.CodeDisconnectACK {
+		t.Errorf("reply %s for the NAS-IPv6-Address of the NAS", reply.Code)
+	}
+}
+
+func TestSession_Dictionary(t *testing.T) {
+	// A dictionary that gives Framed-Interface-Id another number and type.
+	file := filepath.Join(t.TempDir(), "dictionary")
+	if err := os.WriteFile(file, []byte("ATTRIBUTE\tFramed-Interface-Id\t250\tstring\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dict, err := radius.LoadDictionary(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := New("192.0.2.1", &Context{UserName: "user", ClientInterfaceID: "if-1"})
+	s.Dictionary = dict
+
+	p := s.InitPAP()
+	if value, ok := p.Lookup(250); !ok || string(value) != "if-1" {
+		t.Errorf("attribute 250 = %q, want if-1", value)
+	}
+	if _, ok := p.Lookup(radius.FramedInterfaceID_Type); ok {
+		t.Error("Framed-Interface-Id encoded with the default dictionary")
+	}
+}
@@ -0,0 +1,19 @@
+package session
+
+// Stats counts the replies a Session received from the server.
+type Stats struct {
+	Replies          uint64
+	Dropped          uint64
+	BadAuthenticator uint64
+
+	BadMessageAuthenticator uint64
+
+	// MessageAuthenticatorPresent and MessageAuthenticatorFirst count the
+	// authentic replies that carried Message-Authenticator, and those that
+	// carried it as the first attribute, as BlastRADIUS requires.
+	MessageAuthenticatorPresent uint64
+	MessageAuthenticatorFirst   uint64
+
+	// Retransmits counts the requests sent again for lack of a reply.
+	Retransmits uint64
+}
@@ -2,8 +2,10 @@ package tls
 
 import (
 	"crypto/tls"
+	"io"
 	"log"
 	"net"
+	"sync"
 )
 
 type HandShakeStatus int
@@ -26,6 +28,23 @@ type TLSCache struct {
 	out       tlsBuf
 	in        tlsBuf
 	tls       *tls.Conn
+	keyLog    keyLog
+}
+
+// keyLog forwards the key log of the handshake to the writer set by
+// SetKeyLog, if any.
+type keyLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (k *keyLog) Write(p []byte) (int, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.w == nil {
+		return len(p), nil
+	}
+	return k.w.Write(p)
 }
 
 func New() (t *TLSCache, err error) {
@@ -35,6 +54,7 @@ func New() (t *TLSCache, err error) {
 	}
 	conf := &tls.Config{
 		InsecureSkipVerify: true,
+		KeyLogWriter:       &t.keyLog,
 	}
 
 	t.tls = tls.Client(&localConn{&t.out, &t.in}, conf)
@@ -100,3 +120,18 @@ func (t *TLSCache) HandShake(value []byte, len uint32) []byte {
 		return []byte{}
 	}
 }
+
+// SetKeyLog writes the secrets of the handshake to w in the NSS key log
+// format, which Wireshark uses to decrypt the tunnel.
+func (t *TLSCache) SetKeyLog(w io.Writer) {
+	t.keyLog.mu.Lock()
+	defer t.keyLog.mu.Unlock()
+	t.keyLog.w = w
+}
+
+// ExportKeyingMaterial derives keying material from the finished handshake,
+// e.g. the PEAP MSK with label "client EAP encryption".
+func (t *TLSCache) ExportKeyingMaterial(label string, length int) ([]byte, error) {
+	state := t.tls.ConnectionState()
+	return state.ExportKeyingMaterial(label, nil, length)
+}